@@ -15,7 +15,6 @@
 package casdoorsdk
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
@@ -53,7 +52,7 @@ func (c *Client) GetAdapters() ([]*Adapter, error) {
 	}
 
 	var adapters []*Adapter
-	err = json.Unmarshal(bytes, &adapters)
+	err = jsonCodec.Unmarshal(bytes, &adapters)
 	if err != nil {
 		return nil, err
 	}
@@ -72,13 +71,13 @@ func (c *Client) GetPaginationAdapters(p int, pageSize int, queryMap map[string]
 		return nil, 0, err
 	}
 
-	dataBytes, err := json.Marshal(response.Data)
+	dataBytes, err := jsonCodec.Marshal(response.Data)
 	if err != nil {
 		return nil, 0, err
 	}
 
 	var adapters []*Adapter
-	err = json.Unmarshal(dataBytes, &adapters)
+	err = jsonCodec.Unmarshal(dataBytes, &adapters)
 	if err != nil {
 		return nil, 0, errors.New("response data format is incorrect")
 	}
@@ -99,7 +98,7 @@ func (c *Client) GetAdapter(name string) (*Adapter, error) {
 	}
 
 	var adapter *Adapter
-	err = json.Unmarshal(bytes, &adapter)
+	err = jsonCodec.Unmarshal(bytes, &adapter)
 	if err != nil {
 		return nil, err
 	}
@@ -14,10 +14,7 @@
 
 package casdoorsdk
 
-import (
-	"encoding/json"
-	"fmt"
-)
+import "fmt"
 
 type ProviderItem struct {
 	Owner        string    `json:"owner"`
@@ -168,7 +165,7 @@ func (c *Client) GetApplications() ([]*Application, error) {
 	}
 
 	var applications []*Application
-	err = json.Unmarshal(bytes, &applications)
+	err = jsonCodec.Unmarshal(bytes, &applications)
 	if err != nil {
 		return nil, err
 	}
@@ -189,7 +186,7 @@ func (c *Client) GetOrganizationApplications() ([]*Application, error) {
 	}
 
 	var applications []*Application
-	err = json.Unmarshal(bytes, &applications)
+	err = jsonCodec.Unmarshal(bytes, &applications)
 	if err != nil {
 		return nil, err
 	}
@@ -209,7 +206,7 @@ func (c *Client) GetApplication(name string) (*Application, error) {
 	}
 
 	var application *Application
-	err = json.Unmarshal(bytes, &application)
+	err = jsonCodec.Unmarshal(bytes, &application)
 	if err != nil {
 		return nil, err
 	}
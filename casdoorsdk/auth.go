@@ -12,6 +12,8 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
+//go:build !tinygo
+
 package casdoorsdk
 
 import (
@@ -24,61 +26,14 @@ import (
 	"golang.org/x/oauth2"
 )
 
-// AuthConfig is the core configuration.
-// The first step to use this SDK is to use the InitConfig function to initialize the global authConfig.
-type AuthConfig struct {
-	Endpoint         string
-	ClientId         string
-	ClientSecret     string
-	Certificate      string
-	OrganizationName string
-	ApplicationName  string
-}
-
-type Client struct {
-	AuthConfig
-	CustomHeaders map[string]string
-}
-
 // HttpClient interface has the method required to use a type as custom http client.
 // The net/*http.Client type satisfies this interface.
 type HttpClient interface {
 	Do(*http.Request) (*http.Response, error)
 }
 
-type Response struct {
-	Status string      `json:"status"`
-	Msg    string      `json:"msg"`
-	Data   interface{} `json:"data"`
-	Data2  interface{} `json:"data2"`
-}
-
 // client is a shared http Client.
 var client HttpClient = &http.Client{}
-var globalClient *Client
-
-func InitConfig(endpoint string, clientId string, clientSecret string, certificate string, organizationName string, applicationName string) {
-	globalClient = NewClient(endpoint, clientId, clientSecret, certificate, organizationName, applicationName)
-}
-
-func NewClient(endpoint string, clientId string, clientSecret string, certificate string, organizationName string, applicationName string) *Client {
-	return NewClientWithConf(
-		&AuthConfig{
-			Endpoint:         endpoint,
-			ClientId:         clientId,
-			ClientSecret:     clientSecret,
-			Certificate:      certificate,
-			OrganizationName: organizationName,
-			ApplicationName:  applicationName,
-		})
-}
-
-func NewClientWithConf(config *AuthConfig) *Client {
-	return &Client{
-		AuthConfig:    *config,
-		CustomHeaders: make(map[string]string),
-	}
-}
 
 // SetHttpClient sets custom http Client.
 func SetHttpClient(httpClient HttpClient) {
@@ -119,10 +74,7 @@ func (c *Client) GetOAuthToken(code string, state string, opts ...OAuthOption) (
 		Scopes: nil,
 	}
 
-	ctx := context.Background()
-	if options.httpClient != nil {
-		ctx = context.WithValue(ctx, oauth2.HTTPClient, options.httpClient)
-	}
+	ctx := c.getOAuthContext(c.getContext(), options)
 
 	token, err := config.Exchange(ctx, code)
 	if err != nil {
@@ -155,10 +107,7 @@ func (c *Client) RefreshOAuthToken(refreshToken string, opts ...OAuthOption) (*o
 		Scopes: nil,
 	}
 
-	ctx := context.Background()
-	if options.httpClient != nil {
-		ctx = context.WithValue(ctx, oauth2.HTTPClient, options.httpClient)
-	}
+	ctx := c.getOAuthContext(c.getContext(), options)
 
 	token, err := config.TokenSource(ctx, &oauth2.Token{RefreshToken: refreshToken}).Token()
 	if err != nil {
@@ -171,3 +120,29 @@ func (c *Client) RefreshOAuthToken(refreshToken string, opts ...OAuthOption) (*o
 
 	return token, err
 }
+
+// getOAuthContext returns the context passed to the oauth2 package. Its http client sends the token requests
+// like the other API requests: with the client's custom headers, per-attempt timeout and endpoint failover,
+// using the http client of the options or else the shared one.
+func (c *Client) getOAuthContext(ctx context.Context, options *oauthOptions) context.Context {
+	var httpClient HttpClient = client
+	if options.httpClient != nil {
+		httpClient = options.httpClient
+	}
+
+	return context.WithValue(ctx, oauth2.HTTPClient, &http.Client{
+		Transport: &oauthTransport{c: c, httpClient: httpClient},
+	})
+}
+
+// oauthTransport is an http.RoundTripper that sends the requests of the oauth2 package through the client.
+type oauthTransport struct {
+	c          *Client
+	httpClient HttpClient
+}
+
+func (t *oauthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	t.c.setCustomHeaders(req)
+	return t.c.doHttpRequestWith(t.httpClient, req)
+}
@@ -12,6 +12,8 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
+//go:build !tinygo
+
 package casdoorsdk
 
 import "golang.org/x/oauth2"
@@ -0,0 +1,101 @@
+// Copyright 2025 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !tinygo
+
+package casdoorsdk
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+)
+
+// budgetKey is the context key of the deadline of the whole call, which outlives the shorter per-attempt deadline.
+type budgetKey struct{}
+
+// RemainingBudget returns how much time is left before the deadline of the SDK call that ctx belongs to,
+// and false if the call has no deadline. Custom http clients set with SetHttpClient can use it on
+// req.Context() to see the caller's budget, which can be longer than the deadline of the current attempt
+// when AuthConfig.AttemptTimeout is set.
+func RemainingBudget(ctx context.Context) (time.Duration, bool) {
+	if deadline, ok := ctx.Value(budgetKey{}).(time.Time); ok {
+		return time.Until(deadline), true
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		return time.Until(deadline), true
+	}
+	return 0, false
+}
+
+// getContext returns the context set with WithContext, or the background context.
+func (c *Client) getContext() context.Context {
+	if c.ctx == nil {
+		return context.Background()
+	}
+	return c.ctx
+}
+
+// attemptContext derives the context of a single attempt from the context of the call,
+// limited by AttemptTimeout if set, without ever exceeding the call's own deadline.
+func (c *Client) attemptContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if deadline, ok := ctx.Deadline(); ok && ctx.Value(budgetKey{}) == nil {
+		ctx = context.WithValue(ctx, budgetKey{}, deadline)
+	}
+	if c.AttemptTimeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, c.AttemptTimeout)
+}
+
+// doHttpAttempt sends the request once with httpClient, bounded by the per-attempt timeout.
+// The attempt's context is released when the response body is closed.
+func (c *Client) doHttpAttempt(httpClient HttpClient, req *http.Request) (*http.Response, error) {
+	ctx, cancel := c.attemptContext(req.Context())
+	startTime := time.Now()
+	resp, err := httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return resp, err
+	}
+
+	c.observeServerTime(resp, startTime)
+
+	resp.Body = &cancelBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// observeServerTime updates the clock offset estimate with the response's Date header.
+func (c *Client) observeServerTime(resp *http.Response, startTime time.Time) {
+	if c.EstimateClockOffset && c.clock != nil {
+		serverTime, err := http.ParseTime(resp.Header.Get("Date"))
+		if err != nil {
+			return
+		}
+		c.clock.observe(serverTime, startTime)
+	}
+}
+
+// cancelBody is a response body that cancels the context of its request when closed.
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
@@ -0,0 +1,80 @@
+// Copyright 2025 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type budgetRecorder struct {
+	budgets []time.Duration
+}
+
+func (r *budgetRecorder) Do(req *http.Request) (*http.Response, error) {
+	budget, _ := RemainingBudget(req.Context())
+	r.budgets = append(r.budgets, budget)
+	return http.DefaultClient.Do(req)
+}
+
+func TestDeadlineBudget(t *testing.T) {
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(500 * time.Millisecond):
+		}
+	}))
+	defer slow.Close()
+	fast := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":"ok","msg":"","data":{"owner":"built-in","name":"alice"}}`))
+	}))
+	defer fast.Close()
+
+	recorder := &budgetRecorder{}
+	SetHttpClient(recorder)
+	defer SetHttpClient(&http.Client{})
+
+	client := NewClientWithConf(&AuthConfig{
+		Endpoint:          slow.URL,
+		FailoverEndpoints: []string{fast.URL},
+		OrganizationName:  TestCasdoorOrganization,
+		AttemptTimeout:    100 * time.Millisecond,
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	user, err := client.WithContext(ctx).GetUser("alice")
+	if err != nil {
+		t.Fatalf("A timed out attempt should fail over: %v", err)
+	}
+	if user.Name != "alice" {
+		t.Fatalf("Unexpected user: %v", user)
+	}
+	if len(recorder.budgets) != 2 || recorder.budgets[1] <= 100*time.Millisecond || recorder.budgets[1] > time.Second {
+		t.Fatalf("Custom http clients should see the budget of the whole call, got %v", recorder.budgets)
+	}
+
+	client = NewClient(slow.URL, TestClientId, TestClientSecret, TestJwtPublicKey, TestCasdoorOrganization, TestCasdoorApplication)
+	ctx, cancel = context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	startTime := time.Now()
+	_, err = client.WithContext(ctx).RefreshOAuthToken("refresh-token")
+	if err == nil || time.Since(startTime) > time.Second {
+		t.Fatalf("Token refresh should stop at the caller's deadline, got %v after %v", err, time.Since(startTime))
+	}
+}
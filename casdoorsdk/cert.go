@@ -15,8 +15,9 @@
 package casdoorsdk
 
 import (
-	"encoding/json"
+	"errors"
 	"fmt"
+	"strconv"
 )
 
 // Cert has the same definition as https://github.com/casdoor/casdoor/blob/master/object/cert.go#L24
@@ -47,13 +48,38 @@ func (c *Client) GetGlobalCerts() ([]*Cert, error) {
 	}
 
 	var certs []*Cert
-	err = json.Unmarshal(bytes, &certs)
+	err = jsonCodec.Unmarshal(bytes, &certs)
 	if err != nil {
 		return nil, err
 	}
 	return certs, nil
 }
 
+func (c *Client) GetPaginationGlobalCerts(p int, pageSize int, queryMap map[string]string) ([]*Cert, int, error) {
+	queryMap["p"] = strconv.Itoa(p)
+	queryMap["pageSize"] = strconv.Itoa(pageSize)
+
+	url := c.GetUrl("get-global-certs", queryMap)
+
+	response, err := c.DoGetResponse(url)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	dataBytes, err := jsonCodec.Marshal(response.Data)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var certs []*Cert
+	err = jsonCodec.Unmarshal(dataBytes, &certs)
+	if err != nil {
+		return nil, 0, errors.New("response data format is incorrect")
+	}
+
+	return certs, int(response.Data2.(float64)), nil
+}
+
 func (c *Client) GetCerts() ([]*Cert, error) {
 	queryMap := map[string]string{
 		"owner": c.OrganizationName,
@@ -67,7 +93,7 @@ func (c *Client) GetCerts() ([]*Cert, error) {
 	}
 
 	var certs []*Cert
-	err = json.Unmarshal(bytes, &certs)
+	err = jsonCodec.Unmarshal(bytes, &certs)
 	if err != nil {
 		return nil, err
 	}
@@ -87,7 +113,7 @@ func (c *Client) GetCert(name string) (*Cert, error) {
 	}
 
 	var cert *Cert
-	err = json.Unmarshal(bytes, &cert)
+	err = jsonCodec.Unmarshal(bytes, &cert)
 	if err != nil {
 		return nil, err
 	}
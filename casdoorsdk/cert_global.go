@@ -18,6 +18,10 @@ func GetGlobalCerts() ([]*Cert, error) {
 	return globalClient.GetGlobalCerts()
 }
 
+func GetPaginationGlobalCerts(p int, pageSize int, queryMap map[string]string) ([]*Cert, int, error) {
+	return globalClient.GetPaginationGlobalCerts(p, pageSize, queryMap)
+}
+
 func GetCerts() ([]*Cert, error) {
 	return globalClient.GetCerts()
 }
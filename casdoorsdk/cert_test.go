@@ -94,3 +94,26 @@ func TestCert(t *testing.T) {
 		t.Fatalf("Failed to delete object, it's still retrievable")
 	}
 }
+
+func TestGetGlobalCerts(t *testing.T) {
+	server := getTestGlobalListServer(t, "get-global-certs")
+	defer server.Close()
+
+	client := NewClient(server.URL, TestClientId, TestClientSecret, TestJwtPublicKey, TestCasdoorOrganization, TestCasdoorApplication)
+
+	certs, err := client.GetGlobalCerts()
+	if err != nil {
+		t.Fatalf("Failed to get objects: %v", err)
+	}
+	if len(certs) != 2 || certs[0].Name != "a" {
+		t.Fatalf("Unexpected objects: %v", certs)
+	}
+
+	certs, count, err := client.GetPaginationGlobalCerts(2, 10, map[string]string{"field": "name", "value": "a"})
+	if err != nil {
+		t.Fatalf("Failed to get objects: %v", err)
+	}
+	if len(certs) != 2 || count != 25 {
+		t.Fatalf("Expected 2 objects of 25, got %d of %d", len(certs), count)
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright 2021 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"context"
+	"time"
+)
+
+// AuthConfig is the core configuration.
+// The first step to use this SDK is to use the InitConfig function to initialize the global authConfig.
+type AuthConfig struct {
+	Endpoint         string
+	ClientId         string
+	ClientSecret     string
+	Certificate      string
+	OrganizationName string
+	ApplicationName  string
+	// FailoverEndpoints are additional Casdoor server URLs, tried when Endpoint is unavailable.
+	FailoverEndpoints []string
+	// EndpointPolicy chooses between Endpoint and FailoverEndpoints, defaults to EndpointPolicyPriority.
+	EndpointPolicy string
+	// AttemptTimeout limits each attempt of a request, including each failover attempt and OAuth token request.
+	// The deadline of the context set with WithContext still applies to the call as a whole. Zero means no limit.
+	AttemptTimeout time.Duration
+	// ClockSkew is the tolerated difference between the clocks of the Casdoor server and this host,
+	// applied to the exp, nbf and iat claims of tokens and to the expiry of signed embed URLs.
+	ClockSkew time.Duration
+	// EstimateClockOffset estimates the offset of the server's clock from the Date headers of API responses,
+	// and corrects the local time by it when validating tokens and signing or verifying embed URLs.
+	EstimateClockOffset bool
+}
+
+type Client struct {
+	AuthConfig
+	CustomHeaders map[string]string
+
+	ctx        context.Context
+	endpoints  *endpointPool
+	clock      *clockEstimator
+	publicKeys *publicKeyCache
+}
+
+type Response struct {
+	Status string      `json:"status"`
+	Msg    string      `json:"msg"`
+	Data   interface{} `json:"data"`
+	Data2  interface{} `json:"data2"`
+}
+
+var globalClient *Client
+
+func InitConfig(endpoint string, clientId string, clientSecret string, certificate string, organizationName string, applicationName string) {
+	globalClient = NewClient(endpoint, clientId, clientSecret, certificate, organizationName, applicationName)
+}
+
+func NewClient(endpoint string, clientId string, clientSecret string, certificate string, organizationName string, applicationName string) *Client {
+	return NewClientWithConf(
+		&AuthConfig{
+			Endpoint:         endpoint,
+			ClientId:         clientId,
+			ClientSecret:     clientSecret,
+			Certificate:      certificate,
+			OrganizationName: organizationName,
+			ApplicationName:  applicationName,
+		})
+}
+
+func NewClientWithConf(config *AuthConfig) *Client {
+	return &Client{
+		AuthConfig:    *config,
+		CustomHeaders: make(map[string]string),
+		endpoints:     newEndpointPool(),
+		clock:         newClockEstimator(),
+		publicKeys:    newPublicKeyCache(),
+	}
+}
+
+// WithHeader returns a copy of the client that additionally sends the given header on every request,
+// including uploads and OAuth token requests. The original client and its CustomHeaders are left unchanged,
+// which makes it suitable for per-call headers such as correlation IDs:
+//
+//	users, err := client.WithHeader("X-Request-Id", requestId).GetUsers()
+func (c *Client) WithHeader(key string, value string) *Client {
+	headers := make(map[string]string, len(c.CustomHeaders)+1)
+	for k, v := range c.CustomHeaders {
+		headers[k] = v
+	}
+	headers[key] = value
+
+	clone := *c
+	clone.CustomHeaders = headers
+	return &clone
+}
+
+// WithContext returns a copy of the client whose requests, failover retries and OAuth token requests
+// all share ctx, so that they are cancelled together and never outlive its deadline:
+//
+//	ctx, cancel := context.WithTimeout(r.Context(), 2*time.Second)
+//	defer cancel()
+//	token, err := client.WithContext(ctx).RefreshOAuthToken(refreshToken)
+func (c *Client) WithContext(ctx context.Context) *Client {
+	clone := *c
+	clone.ctx = ctx
+	return &clone
+}
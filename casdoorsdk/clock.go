@@ -0,0 +1,91 @@
+// Copyright 2025 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// clockOffsetWeight is the weight of a new sample in the moving average of the clock offset.
+const clockOffsetWeight = 0.3
+
+// clockEstimator estimates the offset of the server's clock from the server's Date headers, shared by copies of the client.
+type clockEstimator struct {
+	mu      sync.Mutex
+	offset  time.Duration
+	samples int
+}
+
+func newClockEstimator() *clockEstimator {
+	return &clockEstimator{}
+}
+
+// observe records the server time of the Date header of a response to a request sent at startTime, NTP-style:
+// the server is assumed to have stamped the response halfway through the round trip.
+func (e *clockEstimator) observe(serverTime time.Time, startTime time.Time) {
+	endTime := time.Now()
+	// The Date header is truncated to the second
+	serverTime = serverTime.Add(500 * time.Millisecond)
+	offset := serverTime.Sub(startTime.Add(endTime.Sub(startTime) / 2))
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.samples == 0 {
+		e.offset = offset
+	} else {
+		e.offset = time.Duration(clockOffsetWeight*float64(offset) + (1-clockOffsetWeight)*float64(e.offset))
+	}
+	e.samples++
+}
+
+func (e *clockEstimator) get() time.Duration {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.offset
+}
+
+// ClockOffset returns the estimated offset of the Casdoor server's clock from the local clock,
+// positive if the server is ahead. It is always zero unless AuthConfig.EstimateClockOffset is set.
+func (c *Client) ClockOffset() time.Duration {
+	if !c.EstimateClockOffset || c.clock == nil {
+		return 0
+	}
+	return c.clock.get()
+}
+
+// now returns the current time, corrected by the estimated clock offset.
+func (c *Client) now() time.Time {
+	return time.Now().Add(c.ClockOffset())
+}
+
+// validateTimeClaims checks the exp, nbf and iat claims of a token against the corrected current time,
+// tolerating a difference of up to ClockSkew between the clocks of the server and this host.
+func (c *Client) validateTimeClaims(claims *jwt.RegisteredClaims) error {
+	now := c.now()
+	if !claims.VerifyExpiresAt(now.Add(-c.ClockSkew), false) {
+		return &jwt.ValidationError{Inner: jwt.ErrTokenExpired, Errors: jwt.ValidationErrorExpired}
+	}
+	if !claims.VerifyNotBefore(now.Add(c.ClockSkew), false) {
+		return &jwt.ValidationError{Inner: jwt.ErrTokenNotValidYet, Errors: jwt.ValidationErrorNotValidYet}
+	}
+	if !claims.VerifyIssuedAt(now.Add(c.ClockSkew), false) {
+		return &jwt.ValidationError{Inner: jwt.ErrTokenUsedBeforeIssued, Errors: jwt.ValidationErrorIssuedAt}
+	}
+	return nil
+}
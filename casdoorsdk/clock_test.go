@@ -0,0 +1,66 @@
+// Copyright 2025 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+func TestClockSkew(t *testing.T) {
+	privateKey, certificate := getTestJwtKeyPair(t)
+	client := NewClient(TestCasdoorEndpoint, TestClientId, TestClientSecret, certificate, TestCasdoorOrganization, TestCasdoorApplication)
+
+	token := getTestJwtToken(t, privateKey, "alice", time.Now().Add(-30*time.Second))
+	_, err := client.ParseJwtToken(token)
+	if !errors.Is(err, jwt.ErrTokenExpired) {
+		t.Fatalf("Expected the token to be expired, got %v", err)
+	}
+
+	client.ClockSkew = time.Minute
+	_, err = client.ParseJwtToken(token)
+	if err != nil {
+		t.Fatalf("Expected the token to be accepted within the clock skew: %v", err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Date", time.Now().Add(time.Hour).UTC().Format(http.TimeFormat))
+		w.Write([]byte(`{"status":"ok","msg":"","data":null}`))
+	}))
+	defer server.Close()
+
+	client = NewClientWithConf(&AuthConfig{
+		Endpoint:            server.URL,
+		Certificate:         certificate,
+		EstimateClockOffset: true,
+	})
+	_, err = client.GetUsers()
+	if err != nil {
+		t.Fatalf("Failed to get users: %v", err)
+	}
+	if offset := client.ClockOffset(); offset < 59*time.Minute || offset > 61*time.Minute {
+		t.Fatalf("Unexpected clock offset: %v", offset)
+	}
+
+	_, err = client.ParseJwtToken(getTestJwtToken(t, privateKey, "alice", time.Now().Add(30*time.Minute)))
+	if !errors.Is(err, jwt.ErrTokenExpired) {
+		t.Fatalf("Expected the token to be expired by the server's clock, got %v", err)
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright 2025 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import "encoding/json"
+
+// JsonCodec marshals and unmarshals the JSON of requests and responses. The default codec uses encoding/json,
+// use SetJsonCodec to replace it with a faster implementation such as jsoniter, segmentio/encoding or sonic.
+type JsonCodec interface {
+	Marshal(v interface{}) ([]byte, error)
+	Unmarshal(data []byte, v interface{}) error
+}
+
+type defaultJsonCodec struct{}
+
+func (defaultJsonCodec) Marshal(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (defaultJsonCodec) Unmarshal(data []byte, v interface{}) error {
+	return json.Unmarshal(data, v)
+}
+
+// jsonCodec is the shared JsonCodec.
+var jsonCodec JsonCodec = defaultJsonCodec{}
+
+// SetJsonCodec sets a custom JsonCodec.
+func SetJsonCodec(codec JsonCodec) {
+	jsonCodec = codec
+}
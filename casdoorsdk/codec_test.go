@@ -0,0 +1,57 @@
+// Copyright 2025 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type testJsonCodec struct {
+	defaultJsonCodec
+	marshals   int
+	unmarshals int
+}
+
+func (c *testJsonCodec) Marshal(v interface{}) ([]byte, error) {
+	c.marshals++
+	return c.defaultJsonCodec.Marshal(v)
+}
+
+func (c *testJsonCodec) Unmarshal(data []byte, v interface{}) error {
+	c.unmarshals++
+	return c.defaultJsonCodec.Unmarshal(data, v)
+}
+
+func TestSetJsonCodec(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":"ok","msg":"","data":"Affected"}`))
+	}))
+	defer server.Close()
+
+	codec := &testJsonCodec{}
+	SetJsonCodec(codec)
+	defer SetJsonCodec(defaultJsonCodec{})
+
+	client := NewClient(server.URL, TestClientId, TestClientSecret, TestJwtPublicKey, TestCasdoorOrganization, TestCasdoorApplication)
+	affected, err := client.AddUser(&User{Name: "alice"})
+	if err != nil || !affected {
+		t.Fatalf("Failed to add user: %v", err)
+	}
+	if codec.marshals == 0 || codec.unmarshals == 0 {
+		t.Fatalf("Custom codec was not used: %d marshals, %d unmarshals", codec.marshals, codec.unmarshals)
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright 2025 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !tinygo
+
+package casdoorsdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"golang.org/x/oauth2"
+)
+
+// getDeviceOAuthConfig returns the oauth2 config for Casdoor's device authorization grant (RFC 8628),
+// which backs scan-to-login: a desktop or TV app shows a QR code and the user approves the login on their phone.
+func (c *Client) getDeviceOAuthConfig() *oauth2.Config {
+	return &oauth2.Config{
+		ClientID:     c.ClientId,
+		ClientSecret: c.ClientSecret,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:       fmt.Sprintf("%s/api/login/oauth/authorize", c.Endpoint),
+			DeviceAuthURL: fmt.Sprintf("%s/api/device-auth", c.Endpoint),
+			TokenURL:      fmt.Sprintf("%s/api/login/oauth/access_token", c.Endpoint),
+			AuthStyle:     oauth2.AuthStyleInParams,
+		},
+		Scopes: []string{"openid", "profile", "email"},
+	}
+}
+
+// StartDeviceLogin creates a cross-device login session. Show the payload of GetDeviceLoginQrPayload
+// as a QR code (or the user code and verification URI as text), then call WaitForDeviceLogin.
+func (c *Client) StartDeviceLogin(opts ...OAuthOption) (*oauth2.DeviceAuthResponse, error) {
+	options := &oauthOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	ctx := c.getOAuthContext(c.getContext(), options)
+	return c.getDeviceOAuthConfig().DeviceAuth(ctx)
+}
+
+// GetDeviceLoginQrPayload returns the URL to encode in the QR code, which opens the approval page
+// with the user code already filled in.
+func GetDeviceLoginQrPayload(deviceAuth *oauth2.DeviceAuthResponse) string {
+	if deviceAuth.VerificationURIComplete != "" {
+		return deviceAuth.VerificationURIComplete
+	}
+
+	separator := "?"
+	if strings.Contains(deviceAuth.VerificationURI, "?") {
+		separator = "&"
+	}
+	return deviceAuth.VerificationURI + separator + "user_code=" + url.QueryEscape(deviceAuth.UserCode)
+}
+
+// WaitForDeviceLogin polls until the user approves or denies the login on the other device,
+// the device code expires or ctx is done, and returns the tokens on approval.
+func (c *Client) WaitForDeviceLogin(ctx context.Context, deviceAuth *oauth2.DeviceAuthResponse, opts ...OAuthOption) (*oauth2.Token, error) {
+	options := &oauthOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	ctx = c.getOAuthContext(ctx, options)
+	token, err := c.getDeviceOAuthConfig().DeviceAccessToken(ctx, deviceAuth)
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.HasPrefix(token.AccessToken, "error:") {
+		return nil, errors.New(strings.TrimPrefix(token.AccessToken, "error: "))
+	}
+
+	return token, nil
+}
@@ -0,0 +1,94 @@
+// Copyright 2025 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+func TestDeviceLogin(t *testing.T) {
+	polls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		r.ParseForm()
+		switch r.URL.Path {
+		case "/api/device-auth":
+			if r.PostForm.Get("client_id") != TestClientId {
+				t.Errorf("Unexpected client id: %s", r.PostForm.Get("client_id"))
+			}
+			w.Write([]byte(`{"device_code":"device-code","user_code":"ABCD-1234","verification_uri":"https://door.casdoor.com/login/device","expires_in":300,"interval":1}`))
+		case "/api/login/oauth/access_token":
+			if r.PostForm.Get("device_code") != "device-code" {
+				t.Errorf("Unexpected device code: %s", r.PostForm.Get("device_code"))
+			}
+			polls++
+			if polls == 1 {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(`{"error":"authorization_pending"}`))
+				return
+			}
+			w.Write([]byte(`{"access_token":"access-token","token_type":"Bearer","expires_in":3600}`))
+		default:
+			t.Errorf("Unexpected request: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, TestClientId, TestClientSecret, TestJwtPublicKey, TestCasdoorOrganization, TestCasdoorApplication)
+
+	deviceAuth, err := client.StartDeviceLogin()
+	if err != nil {
+		t.Fatalf("Failed to start device login: %v", err)
+	}
+	if deviceAuth.UserCode != "ABCD-1234" {
+		t.Fatalf("Unexpected device auth: %+v", deviceAuth)
+	}
+
+	payload := GetDeviceLoginQrPayload(deviceAuth)
+	if payload != "https://door.casdoor.com/login/device?user_code=ABCD-1234" {
+		t.Fatalf("Unexpected QR payload: %s", payload)
+	}
+	payload = GetDeviceLoginQrPayload(&oauth2.DeviceAuthResponse{
+		VerificationURI:         "https://door.casdoor.com/login/device?lang=en",
+		VerificationURIComplete: "https://door.casdoor.com/login/device?user_code=ABCD-1234&lang=en",
+		UserCode:                "ABCD-1234",
+	})
+	if payload != "https://door.casdoor.com/login/device?user_code=ABCD-1234&lang=en" {
+		t.Fatalf("Expected the complete verification URI, got %s", payload)
+	}
+
+	token, err := client.WaitForDeviceLogin(context.Background(), deviceAuth)
+	if err != nil {
+		t.Fatalf("Failed to wait for device login: %v", err)
+	}
+	if token.AccessToken != "access-token" || polls != 2 {
+		t.Fatalf("Unexpected token %+v after %d polls", token, polls)
+	}
+
+	// Cancelled while waiting for the next poll
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	startTime := time.Now()
+	_, err = client.WaitForDeviceLogin(ctx, deviceAuth)
+	if err == nil || time.Since(startTime) > time.Second || polls != 2 {
+		t.Fatalf("Expected waiting to stop when ctx is cancelled, got %v after %d polls", err, polls)
+	}
+}
@@ -14,8 +14,6 @@
 
 package casdoorsdk
 
-import "encoding/json"
-
 type emailForm struct {
 	Title     string   `json:"title"`
 	Content   string   `json:"content"`
@@ -30,7 +28,7 @@ func (c *Client) SendEmail(title string, content string, sender string, receiver
 		Sender:    sender,
 		Receivers: receivers,
 	}
-	postBytes, err := json.Marshal(form)
+	postBytes, err := jsonCodec.Marshal(form)
 	if err != nil {
 		return err
 	}
@@ -49,7 +47,7 @@ func (c *Client) SendEmailByProvider(title string, content string, sender string
 		Sender:    sender,
 		Receivers: receivers,
 	}
-	postBytes, err := json.Marshal(form)
+	postBytes, err := jsonCodec.Marshal(form)
 	if err != nil {
 		return err
 	}
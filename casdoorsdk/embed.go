@@ -0,0 +1,112 @@
+// Copyright 2025 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Casdoor pages that can be embedded in an iframe with GetEmbedUrl.
+const (
+	EmbedPageProfile  = "/account"
+	EmbedPageMfaSetup = "/mfa/setup"
+)
+
+const (
+	embedPageParam       = "embedPage"
+	embedExpireTimeParam = "embedExpireTime"
+	embedSignatureParam  = "embedSignature"
+)
+
+// GetEmbedUrl returns the URL of the Casdoor page at path, e.g. EmbedPageProfile, to show in an iframe.
+// The page is signed in with the access token if it is not empty. Otherwise silentSignin is set,
+// so Casdoor signs the user in with their existing Casdoor session instead of showing the login page.
+func (c *Client) GetEmbedUrl(path string, accessToken string) string {
+	queryMap := url.Values{}
+	if accessToken != "" {
+		queryMap.Set("access_token", accessToken)
+	} else {
+		queryMap.Set("silentSignin", "1")
+	}
+
+	return fmt.Sprintf("%s%s?%s", c.Endpoint, path, queryMap.Encode())
+}
+
+// GetSilentSigninUrl returns the sign-in URL like GetSigninUrl, but Casdoor redirects back without showing
+// the login page if the user has a Casdoor session, which keeps the session continuous inside an iframe.
+func (c *Client) GetSilentSigninUrl(redirectUri string) string {
+	return c.GetSigninUrl(redirectUri) + "&silentSignin=1"
+}
+
+// SignEmbedUrl returns a short-lived link to routeUrl, a route of your own application that serves embeds,
+// carrying the Casdoor page to embed (e.g. EmbedPageProfile), an expire time and an HMAC-SHA256 signature
+// with the client secret. Casdoor itself does not check signatures, so the link must not point at Casdoor:
+// the route checks it with VerifyEmbedUrl and only then redirects the iframe to Casdoor.
+// The link holds no access token, so it is harmless once expired.
+func (c *Client) SignEmbedUrl(routeUrl string, page string, ttl time.Duration) (string, error) {
+	u, err := url.Parse(routeUrl)
+	if err != nil {
+		return "", err
+	}
+
+	expireTime := strconv.FormatInt(c.now().Add(ttl).Unix(), 10)
+	query := u.Query()
+	query.Set(embedPageParam, page)
+	query.Set(embedExpireTimeParam, expireTime)
+	query.Set(embedSignatureParam, c.getEmbedSignature(page, expireTime))
+	u.RawQuery = query.Encode()
+	return u.String(), nil
+}
+
+// VerifyEmbedUrl checks the signature and expire time of a link created by SignEmbedUrl, e.g. r.URL.String()
+// in the route's handler, and returns the URL of the Casdoor page to redirect to, as built by GetEmbedUrl.
+// The access token is typically taken from the user's session in your application. Note that the returned URL
+// holds the access token, which stays valid until the token itself expires.
+func (c *Client) VerifyEmbedUrl(signedUrl string, accessToken string) (string, error) {
+	u, err := url.Parse(signedUrl)
+	if err != nil {
+		return "", err
+	}
+
+	query := u.Query()
+	page := query.Get(embedPageParam)
+	expireTime := query.Get(embedExpireTimeParam)
+	if !hmac.Equal([]byte(query.Get(embedSignatureParam)), []byte(c.getEmbedSignature(page, expireTime))) {
+		return "", errors.New("invalid embed url signature")
+	}
+
+	expireUnix, err := strconv.ParseInt(expireTime, 10, 64)
+	if err != nil {
+		return "", errors.New("invalid embed url expire time")
+	}
+	if c.now().Add(-c.ClockSkew).Unix() > expireUnix {
+		return "", errors.New("embed url has expired")
+	}
+
+	return c.GetEmbedUrl(page, accessToken), nil
+}
+
+func (c *Client) getEmbedSignature(page string, expireTime string) string {
+	mac := hmac.New(sha256.New, []byte(c.ClientSecret))
+	mac.Write([]byte(page + "\n" + expireTime))
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,33 @@
+// Copyright 2025 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import "time"
+
+func GetEmbedUrl(path string, accessToken string) string {
+	return globalClient.GetEmbedUrl(path, accessToken)
+}
+
+func GetSilentSigninUrl(redirectUri string) string {
+	return globalClient.GetSilentSigninUrl(redirectUri)
+}
+
+func SignEmbedUrl(routeUrl string, page string, ttl time.Duration) (string, error) {
+	return globalClient.SignEmbedUrl(routeUrl, page, ttl)
+}
+
+func VerifyEmbedUrl(signedUrl string, accessToken string) (string, error) {
+	return globalClient.VerifyEmbedUrl(signedUrl, accessToken)
+}
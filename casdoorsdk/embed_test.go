@@ -0,0 +1,63 @@
+// Copyright 2025 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSignEmbedUrl(t *testing.T) {
+	client := NewClient(TestCasdoorEndpoint, TestClientId, TestClientSecret, TestJwtPublicKey, TestCasdoorOrganization, TestCasdoorApplication)
+
+	embedUrl := client.GetEmbedUrl(EmbedPageProfile, "")
+	if embedUrl != TestCasdoorEndpoint+"/account?silentSignin=1" {
+		t.Fatalf("Unexpected embed url: %s", embedUrl)
+	}
+
+	signedUrl, err := client.SignEmbedUrl("https://app.example.com/embed", EmbedPageProfile, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign embed url: %v", err)
+	}
+	if !strings.HasPrefix(signedUrl, "https://app.example.com/embed?") || strings.Contains(signedUrl, "access_token") {
+		t.Fatalf("Signed url should point at the app route without a token: %s", signedUrl)
+	}
+
+	// The route sees the path and query of the request
+	route, _ := url.Parse(signedUrl)
+	verifiedUrl, err := client.VerifyEmbedUrl(route.RequestURI(), "token")
+	if err != nil {
+		t.Fatalf("Failed to verify embed url: %v", err)
+	}
+	if verifiedUrl != client.GetEmbedUrl(EmbedPageProfile, "token") {
+		t.Fatalf("Unexpected Casdoor url: %s", verifiedUrl)
+	}
+
+	_, err = client.VerifyEmbedUrl(strings.Replace(signedUrl, "%2Faccount", "%2Fmfa%2Fsetup", 1), "token")
+	if err == nil {
+		t.Fatalf("Expected a tampered embed url to fail verification")
+	}
+
+	expiredUrl, err := client.SignEmbedUrl("https://app.example.com/embed", EmbedPageProfile, -time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign embed url: %v", err)
+	}
+	_, err = client.VerifyEmbedUrl(expiredUrl, "token")
+	if err == nil {
+		t.Fatalf("Expected an expired embed url to fail verification")
+	}
+}
@@ -15,8 +15,10 @@
 package casdoorsdk
 
 import (
-	"encoding/json"
+	"bytes"
 	"errors"
+	"net/url"
+	"strings"
 )
 
 type PermissionRule struct {
@@ -32,28 +34,40 @@ type PermissionRule struct {
 
 type CasbinRequest = []interface{}
 
-func (c *Client) Enforce(permissionId string, modelId string, resourceId string, enforcerId string, owner string, casbinRequest CasbinRequest) (bool, error) {
-	postBytes, err := json.Marshal(casbinRequest)
-	if err != nil {
-		return false, err
-	}
+// enforceResponse is the response of the enforce API, decoded straight into its typed data
+// instead of going through the generic Response.
+type enforceResponse struct {
+	Status string `json:"status"`
+	Msg    string `json:"msg"`
+	Data   []bool `json:"data"`
+}
 
-	res, err := c.doEnforce("enforce", permissionId, modelId, resourceId, enforcerId, owner, postBytes)
+type batchEnforceResponse struct {
+	Status string   `json:"status"`
+	Msg    string   `json:"msg"`
+	Data   [][]bool `json:"data"`
+}
+
+type statusResponse interface {
+	getStatus() (string, string)
+}
+
+func (r *enforceResponse) getStatus() (string, string) {
+	return r.Status, r.Msg
+}
+
+func (r *batchEnforceResponse) getStatus() (string, string) {
+	return r.Status, r.Msg
+}
+
+func (c *Client) Enforce(permissionId string, modelId string, resourceId string, enforcerId string, owner string, casbinRequest CasbinRequest) (bool, error) {
+	var res enforceResponse
+	err := c.doEnforce("enforce", permissionId, modelId, resourceId, enforcerId, owner, casbinRequest, &res)
 	if err != nil {
 		return false, err
 	}
 
-	results, ok := res.Data.([]interface{})
-	if !ok {
-		return false, errors.New("invalid data")
-	}
-
-	for _, result := range results {
-		isAllow, ok := result.(bool)
-		if !ok {
-			return false, errors.New("invalid data")
-		}
-
+	for _, isAllow := range res.Data {
 		if isAllow {
 			return isAllow, nil
 		}
@@ -67,59 +81,64 @@ func Enforce(permissionId string, modelId string, resourceId string, enforcerId
 }
 
 func (c *Client) BatchEnforce(permissionId string, modelId string, resourceId string, enforcerId string, owner string, casbinRequests []CasbinRequest) ([][]bool, error) {
-	postBytes, err := json.Marshal(casbinRequests)
-	if err != nil {
-		return nil, err
-	}
-
-	res, err := c.doEnforce("batch-enforce", permissionId, modelId, resourceId, enforcerId, owner, postBytes)
+	var res batchEnforceResponse
+	err := c.doEnforce("batch-enforce", permissionId, modelId, resourceId, enforcerId, owner, casbinRequests, &res)
 	if err != nil {
 		return nil, err
 	}
 
-	var allows [][]bool
-	data, ok := res.Data.([]interface{})
-	if !ok {
-		return nil, errors.New("invalid data")
-	}
-
-	for _, d := range data {
-		elems, ok := d.([]interface{})
-		if !ok {
-			return nil, errors.New("invalid data")
-		}
-		var permRes []bool
-		for _, el := range elems {
-			r, ok := el.(bool)
-			if !ok {
-				return nil, errors.New("invalid data")
-			}
-			permRes = append(permRes, r)
-		}
-		allows = append(allows, permRes)
-	}
-
-	return allows, nil
+	return res.Data, nil
 }
 
 func BatchEnforce(permissionId string, modelId string, resourceId string, enforcerId string, owner string, casbinRequests []CasbinRequest) ([][]bool, error) {
 	return globalClient.BatchEnforce(permissionId, modelId, resourceId, enforcerId, owner, casbinRequests)
 }
 
-func (c *Client) doEnforce(action string, permissionId string, modelId string, resourceId string, enforcerId string, owner string, postBytes []byte) (*Response, error) {
-	queryMap := map[string]string{
-		"permissionId": permissionId,
-		"modelId":      modelId,
-		"resourceId":   resourceId,
-		"enforcerId":   enforcerId,
-		"owner":        owner,
+// getEnforceUrl builds the enforce URL directly, without the query map of GetUrl.
+func (c *Client) getEnforceUrl(action string, permissionId string, modelId string, resourceId string, enforcerId string, owner string) string {
+	var b strings.Builder
+	b.Grow(len(c.Endpoint) + len(action) + len(permissionId) + len(modelId) + len(resourceId) + len(enforcerId) + len(owner) + 80)
+	b.WriteString(c.Endpoint)
+	b.WriteString("/api/")
+	b.WriteString(action)
+	b.WriteString("?permissionId=")
+	b.WriteString(url.QueryEscape(permissionId))
+	b.WriteString("&modelId=")
+	b.WriteString(url.QueryEscape(modelId))
+	b.WriteString("&resourceId=")
+	b.WriteString(url.QueryEscape(resourceId))
+	b.WriteString("&enforcerId=")
+	b.WriteString(url.QueryEscape(enforcerId))
+	b.WriteString("&owner=")
+	b.WriteString(url.QueryEscape(owner))
+	return b.String()
+}
+
+func (c *Client) doEnforce(action string, permissionId string, modelId string, resourceId string, enforcerId string, owner string, request interface{}, response statusResponse) error {
+	postBytes, err := jsonCodec.Marshal(request)
+	if err != nil {
+		return err
 	}
 
-	// bytes, err := DoPostBytesRaw(url, "", bytes.NewBuffer(postBytes))
-	resp, err := c.DoPost(action, queryMap, postBytes, false, false)
+	respBytes, err := c.DoPostBytesRaw(c.getEnforceUrl(action, permissionId, modelId, resourceId, enforcerId, owner), "", bytes.NewReader(postBytes))
 	if err != nil {
-		return nil, err
+		return err
+	}
+
+	err = jsonCodec.Unmarshal(respBytes, response)
+	if err != nil {
+		// The data of an error response is not a list of results
+		var res Response
+		if jsonCodec.Unmarshal(respBytes, &res) == nil && res.Status != "ok" {
+			return errors.New(res.Msg)
+		}
+		return errors.New("invalid data")
+	}
+
+	status, msg := response.getStatus()
+	if status != "ok" {
+		return errors.New(msg)
 	}
 
-	return resp, nil
+	return nil
 }
@@ -14,7 +14,11 @@
 
 package casdoorsdk
 
-import "testing"
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
 
 func TestEnforce(t *testing.T) {
 	InitConfig(TestCasdoorEndpoint, TestClientId, TestClientSecret, TestJwtPublicKey, TestCasdoorOrganization, TestCasdoorApplication)
@@ -114,3 +118,33 @@ m = r.sub == p.sub && r.obj == p.obj && r.act == p.act`})
 		t.Fatalf("BatchEnforce test fail")
 	}
 }
+
+func TestEnforceResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("enforcerId") != "casbin/enforcer" {
+			w.Write([]byte(`{"status":"error","msg":"the enforcer does not exist","data":null}`))
+		} else if r.URL.Path == "/api/enforce" {
+			w.Write([]byte(`{"status":"ok","msg":"","data":[false,true]}`))
+		} else {
+			w.Write([]byte(`{"status":"ok","msg":"","data":[[true],[false]]}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, TestClientId, TestClientSecret, TestJwtPublicKey, TestCasdoorOrganization, TestCasdoorApplication)
+
+	allowed, err := client.Enforce("", "", "", "casbin/enforcer", "", CasbinRequest{"alice", "data1", "read"})
+	if err != nil || !allowed {
+		t.Fatalf("Expected the request to be allowed, got %v, %v", allowed, err)
+	}
+
+	results, err := client.BatchEnforce("", "", "", "casbin/enforcer", "", []CasbinRequest{{"alice", "data1", "read"}, {"bob", "data1", "read"}})
+	if err != nil || len(results) != 2 || !results[0][0] || results[1][0] {
+		t.Fatalf("Unexpected batch enforce results: %v, %v", results, err)
+	}
+
+	_, err = client.Enforce("", "", "", "casbin/missing", "", CasbinRequest{"alice", "data1", "read"})
+	if err == nil || err.Error() != "the enforcer does not exist" {
+		t.Fatalf("Expected the server error to be returned, got %v", err)
+	}
+}
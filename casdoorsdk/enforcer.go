@@ -15,7 +15,6 @@
 package casdoorsdk
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
@@ -48,7 +47,7 @@ func (c *Client) GetEnforcers() ([]*Enforcer, error) {
 	}
 
 	var enforcers []*Enforcer
-	err = json.Unmarshal(bytes, &enforcers)
+	err = jsonCodec.Unmarshal(bytes, &enforcers)
 	if err != nil {
 		return nil, err
 	}
@@ -67,13 +66,13 @@ func (c *Client) GetPaginationEnforcers(p int, pageSize int, queryMap map[string
 		return nil, 0, err
 	}
 
-	dataBytes, err := json.Marshal(response.Data)
+	dataBytes, err := jsonCodec.Marshal(response.Data)
 	if err != nil {
 		return nil, 0, err
 	}
 
 	var enforcers []*Enforcer
-	err = json.Unmarshal(dataBytes, &enforcers)
+	err = jsonCodec.Unmarshal(dataBytes, &enforcers)
 	if err != nil {
 		return nil, 0, errors.New("response data format is incorrect")
 	}
@@ -94,7 +93,7 @@ func (c *Client) GetEnforcer(name string) (*Enforcer, error) {
 	}
 
 	var enforcer *Enforcer
-	err = json.Unmarshal(bytes, &enforcer)
+	err = jsonCodec.Unmarshal(bytes, &enforcer)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,191 @@
+// Copyright 2025 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Policies for choosing between multiple endpoints, set in AuthConfig.EndpointPolicy.
+const (
+	// EndpointPolicyPriority prefers endpoints in their configured order, Endpoint first.
+	EndpointPolicyPriority = "priority"
+	// EndpointPolicyRoundRobin spreads requests evenly over the healthy endpoints.
+	EndpointPolicyRoundRobin = "round-robin"
+	// EndpointPolicyLowestLatency prefers the healthy endpoint with the lowest measured latency.
+	EndpointPolicyLowestLatency = "lowest-latency"
+)
+
+const (
+	// endpointCooldown is how long an endpoint is skipped after a failed request, unless no other endpoint is healthy.
+	endpointCooldown = 30 * time.Second
+	// endpointLatencyTtl is how long a latency measurement is used, after which the endpoint is probed again by real traffic.
+	endpointLatencyTtl = time.Minute
+	// endpointLatencyWeight is the weight of a new measurement in the moving average of an endpoint's latency.
+	endpointLatencyWeight = 0.3
+)
+
+// EndpointStats is a snapshot of the health and usage of one endpoint, as returned by ClientStats.
+type EndpointStats struct {
+	Endpoint string
+	Healthy  bool
+	Requests int64
+	Failures int64
+	// Latency is the moving average of the successful requests' latency.
+	Latency       time.Duration
+	LastError     string
+	LastErrorTime time.Time
+}
+
+type endpointHealth struct {
+	requests       int64
+	failures       int64
+	latency        time.Duration
+	latencyTime    time.Time
+	unhealthyUntil time.Time
+	lastError      string
+	lastErrorTime  time.Time
+}
+
+// endpointPool tracks the health of a client's endpoints, shared by copies of the client.
+type endpointPool struct {
+	mu      sync.Mutex
+	health  map[string]*endpointHealth
+	counter int
+}
+
+func newEndpointPool() *endpointPool {
+	return &endpointPool{
+		health: map[string]*endpointHealth{},
+	}
+}
+
+func (p *endpointPool) getHealth(endpoint string) *endpointHealth {
+	h, ok := p.health[endpoint]
+	if !ok {
+		h = &endpointHealth{}
+		p.health[endpoint] = h
+	}
+	return h
+}
+
+// order returns the endpoints to try, healthy ones first in the order of the policy, then unhealthy ones in their configured order.
+func (p *endpointPool) order(endpoints []string, policy string) []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+	healthy := []string{}
+	unhealthy := []string{}
+	for _, endpoint := range endpoints {
+		if now.Before(p.getHealth(endpoint).unhealthyUntil) {
+			unhealthy = append(unhealthy, endpoint)
+		} else {
+			healthy = append(healthy, endpoint)
+		}
+	}
+
+	switch policy {
+	case EndpointPolicyRoundRobin:
+		if len(healthy) > 1 {
+			offset := p.counter % len(healthy)
+			healthy = append(append([]string{}, healthy[offset:]...), healthy[:offset]...)
+		}
+		p.counter++
+	case EndpointPolicyLowestLatency:
+		// Endpoints without a recent measurement come first, so they are probed by the next request
+		getLatency := func(endpoint string) time.Duration {
+			h := p.getHealth(endpoint)
+			if now.Sub(h.latencyTime) > endpointLatencyTtl {
+				return 0
+			}
+			return h.latency
+		}
+		sort.SliceStable(healthy, func(i, j int) bool {
+			return getLatency(healthy[i]) < getLatency(healthy[j])
+		})
+	}
+
+	return append(healthy, unhealthy...)
+}
+
+func (p *endpointPool) markFailure(endpoint string, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	h := p.getHealth(endpoint)
+	h.requests++
+	h.failures++
+	h.unhealthyUntil = time.Now().Add(endpointCooldown)
+	h.lastErrorTime = time.Now()
+	h.lastError = err.Error()
+}
+
+func (p *endpointPool) markSuccess(endpoint string, latency time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	h := p.getHealth(endpoint)
+	h.requests++
+	h.unhealthyUntil = time.Time{}
+	if h.latency == 0 {
+		h.latency = latency
+	} else {
+		h.latency = time.Duration(endpointLatencyWeight*float64(latency) + (1-endpointLatencyWeight)*float64(h.latency))
+	}
+	h.latencyTime = time.Now()
+}
+
+func (p *endpointPool) stats(endpoints []string) []*EndpointStats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+	stats := []*EndpointStats{}
+	for _, endpoint := range endpoints {
+		h := p.getHealth(endpoint)
+		stats = append(stats, &EndpointStats{
+			Endpoint:      endpoint,
+			Healthy:       !now.Before(h.unhealthyUntil),
+			Requests:      h.requests,
+			Failures:      h.failures,
+			Latency:       h.latency,
+			LastError:     h.lastError,
+			LastErrorTime: h.lastErrorTime,
+		})
+	}
+	return stats
+}
+
+// getEndpoints returns the primary endpoint followed by the failover endpoints.
+func (c *Client) getEndpoints() []string {
+	endpoints := []string{c.Endpoint}
+	for _, endpoint := range c.FailoverEndpoints {
+		endpoints = append(endpoints, strings.TrimRight(endpoint, "/"))
+	}
+	return endpoints
+}
+
+// ClientStats returns a snapshot of the health, request counts and latency of the client's endpoints.
+// Statistics are only collected when failover endpoints are configured.
+func (c *Client) ClientStats() []*EndpointStats {
+	if c.endpoints == nil {
+		return []*EndpointStats{}
+	}
+	return c.endpoints.stats(c.getEndpoints())
+}
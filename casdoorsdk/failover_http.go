@@ -0,0 +1,98 @@
+// Copyright 2025 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !tinygo
+
+package casdoorsdk
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// isDialError returns true if the request failed before reaching the server, so it is safe to send it again.
+func isDialError(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// doHttpRequest sends the request with the shared http client. If failover endpoints are configured,
+// the endpoint is chosen by the client's EndpointPolicy, and a request to an unavailable endpoint is sent
+// again to the next one. Idempotent requests are retried on network errors and 5xx responses,
+// other requests only if the connection could not be established. Each attempt is limited by AttemptTimeout,
+// and no attempt is started once the context of the request is done.
+func (c *Client) doHttpRequest(req *http.Request) (*http.Response, error) {
+	return c.doHttpRequestWith(client, req)
+}
+
+// doHttpRequestWith is like doHttpRequest, but sends the attempts with httpClient.
+func (c *Client) doHttpRequestWith(httpClient HttpClient, req *http.Request) (*http.Response, error) {
+	reqUrl := req.URL.String()
+	if len(c.FailoverEndpoints) == 0 || c.endpoints == nil || !strings.HasPrefix(reqUrl, c.Endpoint) {
+		return c.doHttpAttempt(httpClient, req)
+	}
+
+	path := strings.TrimPrefix(reqUrl, c.Endpoint)
+	isIdempotent := req.Method == http.MethodGet || req.Method == http.MethodHead
+	endpoints := c.endpoints.order(c.getEndpoints(), c.EndpointPolicy)
+
+	var resp *http.Response
+	var err error
+	for i, endpoint := range endpoints {
+		attempt := req.Clone(req.Context())
+		attempt.URL, err = url.Parse(endpoint + path)
+		if err != nil {
+			return nil, err
+		}
+		attempt.Host = ""
+		if req.Body != nil && req.GetBody != nil {
+			attempt.Body, err = req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		startTime := time.Now()
+		resp, err = c.doHttpAttempt(httpClient, attempt)
+		if err == nil && (!isIdempotent || resp.StatusCode < http.StatusInternalServerError) {
+			c.endpoints.markSuccess(endpoint, time.Since(startTime))
+			return resp, nil
+		}
+
+		// A request the caller cancelled says nothing about the endpoint's health
+		if req.Context().Err() == nil {
+			if err != nil {
+				c.endpoints.markFailure(endpoint, err)
+			} else {
+				c.endpoints.markFailure(endpoint, errors.New(http.StatusText(resp.StatusCode)))
+			}
+		}
+
+		// The caller's deadline covers all attempts, an exhausted budget is not retried
+		isLast := i == len(endpoints)-1 || req.Context().Err() != nil
+		canRetry := req.Body == nil || req.GetBody != nil
+		if (err != nil && !isIdempotent && !isDialError(err)) || isLast || !canRetry {
+			return resp, err
+		}
+		if resp != nil {
+			resp.Body.Close()
+		}
+	}
+
+	return resp, err
+}
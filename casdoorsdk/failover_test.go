@@ -0,0 +1,166 @@
+// Copyright 2025 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestFailover(t *testing.T) {
+	down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	down.Close()
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer failing.Close()
+
+	requests := 0
+	standby := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte(`{"status":"ok","msg":"","data":"Affected"}`))
+	}))
+	defer standby.Close()
+
+	client := NewClientWithConf(&AuthConfig{
+		Endpoint:          down.URL,
+		ClientId:          TestClientId,
+		ClientSecret:      TestClientSecret,
+		OrganizationName:  TestCasdoorOrganization,
+		ApplicationName:   TestCasdoorApplication,
+		FailoverEndpoints: []string{failing.URL, standby.URL},
+	})
+
+	_, err := client.DoGetResponse(client.GetUrl("get-users", nil))
+	if err != nil {
+		t.Fatalf("Failed to get response with failover: %v", err)
+	}
+	_, err = client.DoPost("upload-resource", nil, []byte("file"), true, true)
+	if err != nil {
+		t.Fatalf("Failed to post with failover: %v", err)
+	}
+	if requests != 2 {
+		t.Fatalf("Expected 2 requests on the standby endpoint, got %d", requests)
+	}
+
+	order := client.endpoints.order(client.getEndpoints(), client.EndpointPolicy)
+	if order[0] != standby.URL {
+		t.Fatalf("Expected the healthy standby endpoint to be tried first, got %v", order)
+	}
+
+	// Non-idempotent requests are not resent after reaching a server
+	client.endpoints = newEndpointPool()
+	client.FailoverEndpoints = []string{standby.URL}
+	client.Endpoint = failing.URL
+	_, err = client.DoPost("add-user", nil, []byte("{}"), false, false)
+	if err == nil || requests != 2 {
+		t.Fatalf("Expected the failed POST not to be retried, got error %v and %d requests", err, requests)
+	}
+
+	// Token requests fail over too
+	tokenStandby := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/login/oauth/refresh_token" || r.Header.Get("X-Request-Id") != "request" {
+			t.Errorf("Unexpected token request: %s %v", r.URL.Path, r.Header)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token":"access-token","token_type":"Bearer","expires_in":3600}`))
+	}))
+	defer tokenStandby.Close()
+
+	client = NewClientWithConf(&AuthConfig{
+		Endpoint:          down.URL,
+		ClientId:          TestClientId,
+		ClientSecret:      TestClientSecret,
+		FailoverEndpoints: []string{tokenStandby.URL},
+	})
+	token, err := client.WithHeader("X-Request-Id", "request").RefreshOAuthToken("refresh-token")
+	if err != nil || token.AccessToken != "access-token" {
+		t.Fatalf("Failed to refresh token with failover: %v", err)
+	}
+}
+
+func TestEndpointPolicy(t *testing.T) {
+	endpoints := []string{"https://a.example.com", "https://b.example.com", "https://c.example.com"}
+
+	pool := newEndpointPool()
+	first := pool.order(endpoints, EndpointPolicyRoundRobin)
+	second := pool.order(endpoints, EndpointPolicyRoundRobin)
+	if first[0] != endpoints[0] || second[0] != endpoints[1] || len(second) != 3 {
+		t.Fatalf("Round robin did not rotate the endpoints: %v, %v", first, second)
+	}
+
+	pool = newEndpointPool()
+	pool.markSuccess(endpoints[0], 30*time.Millisecond)
+	pool.markSuccess(endpoints[1], 10*time.Millisecond)
+	pool.markSuccess(endpoints[2], 20*time.Millisecond)
+	order := pool.order(endpoints, EndpointPolicyLowestLatency)
+	if order[0] != endpoints[1] || order[1] != endpoints[2] || order[2] != endpoints[0] {
+		t.Fatalf("Endpoints are not ordered by latency: %v", order)
+	}
+
+	pool.markFailure(endpoints[1], errors.New("connection refused"))
+	order = pool.order(endpoints, EndpointPolicyLowestLatency)
+	if order[2] != endpoints[1] {
+		t.Fatalf("Unhealthy endpoint is not tried last: %v", order)
+	}
+
+	stats := pool.stats(endpoints)
+	if stats[1].Healthy || stats[1].Failures != 1 || stats[1].Requests != 2 || stats[1].LastError != "connection refused" {
+		t.Fatalf("Unexpected endpoint stats: %+v", stats[1])
+	}
+}
+
+func TestFailoverCancel(t *testing.T) {
+	release := make(chan struct{})
+	primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	}))
+	defer primary.Close()
+	defer close(release)
+
+	standby := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Cancelled request was sent to the standby endpoint")
+	}))
+	defer standby.Close()
+
+	client := NewClientWithConf(&AuthConfig{
+		Endpoint:          primary.URL,
+		ClientId:          TestClientId,
+		ClientSecret:      TestClientSecret,
+		OrganizationName:  TestCasdoorOrganization,
+		ApplicationName:   TestCasdoorApplication,
+		FailoverEndpoints: []string{standby.URL},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+	_, err := client.WithContext(ctx).DoGetResponse(client.GetUrl("get-users", nil))
+	if err == nil {
+		t.Fatalf("Expected the cancelled request to fail")
+	}
+
+	stats := client.ClientStats()
+	if !stats[0].Healthy || stats[0].Failures != 0 {
+		t.Fatalf("Expected the primary endpoint to stay healthy after a cancelled request, got: %+v", stats[0])
+	}
+}
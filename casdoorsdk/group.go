@@ -15,7 +15,6 @@
 package casdoorsdk
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
@@ -54,7 +53,7 @@ func (c *Client) GetGroups() ([]*Group, error) {
 	}
 
 	var groups []*Group
-	err = json.Unmarshal(bytes, &groups)
+	err = jsonCodec.Unmarshal(bytes, &groups)
 	if err != nil {
 		return nil, err
 	}
@@ -73,13 +72,13 @@ func (c *Client) GetPaginationGroups(p int, pageSize int, queryMap map[string]st
 		return nil, 0, err
 	}
 
-	dataBytes, err := json.Marshal(response.Data)
+	dataBytes, err := jsonCodec.Marshal(response.Data)
 	if err != nil {
 		return nil, 0, err
 	}
 
 	var groups []*Group
-	err = json.Unmarshal(dataBytes, &groups)
+	err = jsonCodec.Unmarshal(dataBytes, &groups)
 	if err != nil {
 		return nil, 0, errors.New("response data format is incorrect")
 	}
@@ -100,7 +99,7 @@ func (c *Client) GetGroup(name string) (*Group, error) {
 	}
 
 	var group *Group
-	err = json.Unmarshal(bytes, &group)
+	err = jsonCodec.Unmarshal(bytes, &group)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,138 @@
+// Copyright 2025 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+)
+
+// GuestUserType is the user type of anonymous users created by CreateGuestUser.
+const GuestUserType = "guest-user"
+
+// IsGuest returns true if the user was created by CreateGuestUser and has not been upgraded yet.
+func (u User) IsGuest() bool {
+	return u.Type == GuestUserType
+}
+
+func getRandomHex(n int) (string, error) {
+	b := make([]byte, n)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// CreateGuestUser creates an anonymous user in the client's organization and returns it with its generated password.
+// The application must allow password sign-in for the guest to be able to get tokens.
+func (c *Client) CreateGuestUser() (*User, string, error) {
+	suffix, err := getRandomHex(8)
+	if err != nil {
+		return nil, "", err
+	}
+	password, err := getRandomHex(16)
+	if err != nil {
+		return nil, "", err
+	}
+
+	user := &User{
+		Owner:             c.OrganizationName,
+		Name:              "guest_" + suffix,
+		CreatedTime:       GetCurrentTime(),
+		Type:              GuestUserType,
+		DisplayName:       "Guest",
+		Password:          password,
+		SignupApplication: c.ApplicationName,
+	}
+
+	_, err = c.AddUser(user)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return user, password, nil
+}
+
+// ParseGuestToken parses and verifies a token like ParseJwtToken, and returns an error if it was not issued to a guest user.
+func (c *Client) ParseGuestToken(token string) (*Claims, error) {
+	claims, err := c.ParseJwtToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	if !claims.User.IsGuest() {
+		return nil, errors.New("token was not issued to a guest user")
+	}
+
+	return claims, nil
+}
+
+// UpgradeGuestUser turns a guest into a full user with the profile of user, e.g. on signup.
+// The guest is reloaded from the server and only the non-empty profile fields of user are written to it,
+// so its id is kept and data tied to the guest identity carries over, while its name may change.
+// If user.Password is set, it replaces the generated guest password. On success, user holds the upgraded user.
+func (c *Client) UpgradeGuestUser(guest *User, user *User) (bool, error) {
+	stored, err := c.GetUser(guest.Name)
+	if err != nil {
+		return false, err
+	}
+	if stored == nil {
+		return false, errors.New("guest user not found")
+	}
+	if !stored.IsGuest() {
+		return false, errors.New("user is not a guest")
+	}
+
+	id := stored.GetId()
+	columns := []string{"type"}
+	merge := func(column string, field *string, value string) {
+		if value != "" {
+			*field = value
+			columns = append(columns, column)
+		}
+	}
+	merge("name", &stored.Name, user.Name)
+	merge("displayName", &stored.DisplayName, user.DisplayName)
+	merge("firstName", &stored.FirstName, user.FirstName)
+	merge("lastName", &stored.LastName, user.LastName)
+	merge("email", &stored.Email, user.Email)
+	merge("phone", &stored.Phone, user.Phone)
+	merge("countryCode", &stored.CountryCode, user.CountryCode)
+	merge("avatar", &stored.Avatar, user.Avatar)
+
+	stored.Type = user.Type
+	if stored.Type == "" || stored.Type == GuestUserType {
+		stored.Type = "normal-user"
+	}
+
+	_, affected, err := c.modifyUserById("update-user", id, stored, columns)
+	if err != nil {
+		return false, err
+	}
+
+	password := user.Password
+	*user = *stored
+	if password != "" {
+		_, err = c.SetPassword(user.Owner, user.Name, "", password)
+		if err != nil {
+			return affected, err
+		}
+		user.Password = password
+	}
+
+	return affected, nil
+}
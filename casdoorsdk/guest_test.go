@@ -0,0 +1,109 @@
+// Copyright 2025 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+func TestGuestUser(t *testing.T) {
+	privateKey, certificate := getTestJwtKeyPair(t)
+
+	var guest User
+	var login LoginForm
+	updateQuery := url.Values{}
+	var update User
+	passwordForm := url.Values{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/add-user":
+			json.NewDecoder(r.Body).Decode(&guest)
+			w.Write([]byte(`{"status":"ok","msg":"","data":"Affected"}`))
+		case "/api/login":
+			json.NewDecoder(r.Body).Decode(&login)
+			w.Write([]byte(`{"status":"ok","msg":"","data":"guest-code"}`))
+		case "/api/login/oauth/access_token":
+			r.ParseForm()
+			if r.PostForm.Get("code") != "guest-code" {
+				t.Errorf("Unexpected code: %s", r.PostForm.Get("code"))
+			}
+			token, _ := jwt.NewWithClaims(jwt.SigningMethodRS256, Claims{
+				User:             User{Owner: guest.Owner, Name: guest.Name, Type: GuestUserType},
+				RegisteredClaims: jwt.RegisteredClaims{ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour))},
+			}).SignedString(privateKey)
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(map[string]interface{}{"access_token": token, "token_type": "Bearer", "expires_in": 3600})
+		case "/api/get-user":
+			// The server assigned the id, which the locally created guest does not know
+			stored := guest
+			stored.Id = "server-id"
+			stored.Password = "***"
+			json.NewEncoder(w).Encode(Response{Status: "ok", Data: stored})
+		case "/api/update-user":
+			updateQuery = r.URL.Query()
+			json.NewDecoder(r.Body).Decode(&update)
+			w.Write([]byte(`{"status":"ok","msg":"","data":"Affected"}`))
+		case "/api/set-password":
+			r.ParseMultipartForm(1 << 20)
+			passwordForm = r.PostForm
+			w.Write([]byte(`{"status":"ok","msg":"","data":""}`))
+		default:
+			body, _ := io.ReadAll(r.Body)
+			t.Errorf("Unexpected request: %s %s", r.URL.Path, body)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, TestClientId, TestClientSecret, certificate, TestCasdoorOrganization, TestCasdoorApplication)
+
+	user, token, err := client.GetGuestToken("http://localhost/callback")
+	if err != nil {
+		t.Fatalf("Failed to get guest token: %v", err)
+	}
+	if !guest.IsGuest() || guest.Name != user.Name || login.Username != guest.Name || login.Password != guest.Password {
+		t.Fatalf("Guest was not created and signed in: %+v, %+v", guest, login)
+	}
+
+	claims, err := client.ParseGuestToken(token.AccessToken)
+	if err != nil || claims.Name != user.Name {
+		t.Fatalf("Failed to parse guest token: %+v, %v", claims, err)
+	}
+	_, err = client.ParseGuestToken(getTestJwtToken(t, privateKey, "alice", time.Now().Add(time.Hour)))
+	if err == nil {
+		t.Fatalf("Expected a token of a normal user to be rejected")
+	}
+
+	affected, err := client.UpgradeGuestUser(user, &User{Name: "alice", Email: "alice@example.com", Password: "123456"})
+	if err != nil || !affected {
+		t.Fatalf("Failed to upgrade guest: %v", err)
+	}
+	if updateQuery.Get("id") != guest.GetId() || updateQuery.Get("columns") != "type,name,email" {
+		t.Fatalf("Unexpected update query: %v", updateQuery)
+	}
+	if update.Id != "server-id" || update.Name != "alice" || update.Email != "alice@example.com" || update.Type != "normal-user" || update.DisplayName != "Guest" {
+		t.Fatalf("Unexpected updated user: %+v", update)
+	}
+	if passwordForm.Get("userName") != "alice" || passwordForm.Get("newPassword") != "123456" {
+		t.Fatalf("Unexpected password form: %v", passwordForm)
+	}
+}
@@ -15,7 +15,6 @@
 package casdoorsdk
 
 import (
-	"encoding/json"
 	"fmt"
 	"strconv"
 )
@@ -57,7 +56,7 @@ func (c *Client) GetInvitations() ([]*Invitation, error) {
 	}
 
 	var invitations []*Invitation
-	err = json.Unmarshal(bytes, &invitations)
+	err = jsonCodec.Unmarshal(bytes, &invitations)
 	if err != nil {
 		return nil, err
 	}
@@ -76,13 +75,13 @@ func (c *Client) GetPaginationInvitations(p int, pageSize int, queryMap map[stri
 		return nil, 0, err
 	}
 
-	dataBytes, err := json.Marshal(response.Data)
+	dataBytes, err := jsonCodec.Marshal(response.Data)
 	if err != nil {
 		return nil, 0, err
 	}
 
 	var invitations []*Invitation
-	err = json.Unmarshal(dataBytes, &invitations)
+	err = jsonCodec.Unmarshal(dataBytes, &invitations)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -103,7 +102,7 @@ func (c *Client) GetInvitation(name string) (*Invitation, error) {
 	}
 
 	var invitation *Invitation
-	err = json.Unmarshal(bytes, &invitation)
+	err = jsonCodec.Unmarshal(bytes, &invitation)
 	if err != nil {
 		return nil, err
 	}
@@ -125,7 +124,7 @@ func (c *Client) GetInvitationInfo(code string, applicationName string) (*Invita
 	}
 
 	var invitation *Invitation
-	err = json.Unmarshal(bytes, &invitation)
+	err = jsonCodec.Unmarshal(bytes, &invitation)
 	if err != nil {
 		return nil, err
 	}
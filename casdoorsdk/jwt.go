@@ -15,7 +15,9 @@
 package casdoorsdk
 
 import (
+	"errors"
 	"fmt"
+	"sync"
 
 	"github.com/golang-jwt/jwt/v4"
 )
@@ -34,27 +36,118 @@ func (c Claims) IsRefreshToken() bool {
 	return c.RefreshTokenType == "refresh-token"
 }
 
-func (c *Client) ParseJwtToken(token string) (*Claims, error) {
-	t, err := jwt.ParseWithClaims(token, &Claims{}, func(token *jwt.Token) (interface{}, error) {
+// JwtBackend verifies and parses JWTs issued by Casdoor. The default backend uses github.com/golang-jwt/jwt,
+// use SetJwtBackend to replace it, e.g. with an organization-approved or FIPS-certified implementation.
+type JwtBackend interface {
+	// ParseJwtToken verifies the token's signature with the certificate (in PEM format) and unmarshals its payload into claims.
+	// The time-based claims are validated afterwards by the client, so that AuthConfig.ClockSkew applies to them.
+	ParseJwtToken(token string, certificate string, claims *Claims) error
+}
+
+type defaultJwtBackend struct{}
+
+// jwtParser is shared by all validations, it holds no per-token state.
+// The time-based claims are validated by the client with its clock skew tolerance.
+var jwtParser = jwt.NewParser(jwt.WithoutClaimsValidation())
+
+// publicKeyCache holds the public keys parsed from a client's certificate, so its PEM is only parsed once
+// instead of on every token validation. It is shared by copies of the client and only keeps the keys
+// of the current certificate, those of a replaced certificate are dropped.
+type publicKeyCache struct {
+	mu          sync.RWMutex
+	certificate string
+	keys        map[string]interface{}
+}
+
+func newPublicKeyCache() *publicKeyCache {
+	return &publicKeyCache{keys: map[string]interface{}{}}
+}
+
+func (p *publicKeyCache) get(keyType string, certificate string) (interface{}, error) {
+	if p == nil {
+		return parsePublicKey(keyType, certificate)
+	}
+
+	p.mu.RLock()
+	key, ok := p.keys[keyType]
+	if ok && p.certificate == certificate {
+		p.mu.RUnlock()
+		return key, nil
+	}
+	p.mu.RUnlock()
+
+	key, err := parsePublicKey(keyType, certificate)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.certificate != certificate {
+		p.certificate = certificate
+		p.keys = map[string]interface{}{}
+	}
+	p.keys[keyType] = key
+	return key, nil
+}
+
+func parsePublicKey(keyType string, certificate string) (interface{}, error) {
+	if keyType == "ec" {
+		return jwt.ParseECPublicKeyFromPEM([]byte(certificate))
+	}
+	return jwt.ParseRSAPublicKeyFromPEM([]byte(certificate))
+}
+
+func (b defaultJwtBackend) ParseJwtToken(token string, certificate string, claims *Claims) error {
+	return parseJwtToken(token, certificate, claims, nil)
+}
+
+// parseJwtToken verifies the token with the default backend, taking the public key from keys if not nil.
+func parseJwtToken(token string, certificate string, claims *Claims, keys *publicKeyCache) error {
+	t, err := jwtParser.ParseWithClaims(token, claims, func(token *jwt.Token) (interface{}, error) {
 		switch token.Method.Alg() {
-		case jwt.SigningMethodES256.Alg():
-			return jwt.ParseECPublicKeyFromPEM([]byte(c.Certificate))
-		case jwt.SigningMethodES512.Alg():
-			return jwt.ParseECPublicKeyFromPEM([]byte(c.Certificate))
-		case jwt.SigningMethodRS256.Alg():
-			return jwt.ParseRSAPublicKeyFromPEM([]byte(c.Certificate))
-		case jwt.SigningMethodRS512.Alg():
-			return jwt.ParseRSAPublicKeyFromPEM([]byte(c.Certificate))
+		case jwt.SigningMethodES256.Alg(), jwt.SigningMethodES512.Alg():
+			return keys.get("ec", certificate)
+		case jwt.SigningMethodRS256.Alg(), jwt.SigningMethodRS512.Alg():
+			return keys.get("rsa", certificate)
 		default:
 			return nil, fmt.Errorf("unsupported signing method: %v", token.Header["alg"])
 		}
 	})
+	if err != nil {
+		return err
+	}
 
-	if t != nil {
-		if claims, ok := t.Claims.(*Claims); ok && t.Valid {
-			return claims, nil
-		}
+	if !t.Valid {
+		return errors.New("token is invalid")
+	}
+	return nil
+}
+
+// jwtBackend is the shared JwtBackend.
+var jwtBackend JwtBackend = defaultJwtBackend{}
+
+// SetJwtBackend sets a custom JwtBackend.
+func SetJwtBackend(backend JwtBackend) {
+	jwtBackend = backend
+}
+
+func (c *Client) ParseJwtToken(token string) (*Claims, error) {
+	claims := &Claims{}
+	var err error
+	if _, ok := jwtBackend.(defaultJwtBackend); ok {
+		err = parseJwtToken(token, c.Certificate, claims, c.publicKeys)
+	} else {
+		err = jwtBackend.ParseJwtToken(token, c.Certificate, claims)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.validateTimeClaims(&claims.RegisteredClaims)
+	if err != nil {
+		return nil, err
 	}
 
-	return nil, err
+	return claims, nil
 }
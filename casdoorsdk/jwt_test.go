@@ -0,0 +1,139 @@
+// Copyright 2025 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+func getTestJwtKeyPair(t testing.TB) (*rsa.PrivateKey, string) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "Casdoor Cert"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	certBytes, err := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+	if err != nil {
+		t.Fatalf("Failed to create certificate: %v", err)
+	}
+
+	return privateKey, string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certBytes}))
+}
+
+func getTestJwtToken(t testing.TB, privateKey *rsa.PrivateKey, name string, expiresAt time.Time) string {
+	claims := Claims{
+		User: User{Owner: TestCasdoorOrganization, Name: name},
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+		},
+	}
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodRS256, claims).SignedString(privateKey)
+	if err != nil {
+		t.Fatalf("Failed to sign token: %v", err)
+	}
+	return token
+}
+
+type testJwtBackend struct {
+	calls int
+}
+
+func (b *testJwtBackend) ParseJwtToken(token string, certificate string, claims *Claims) error {
+	b.calls++
+	if token != "valid" {
+		return errors.New("invalid token")
+	}
+	claims.Name = "alice"
+	return nil
+}
+
+func TestParseJwtToken(t *testing.T) {
+	privateKey, certificate := getTestJwtKeyPair(t)
+	client := NewClient(TestCasdoorEndpoint, TestClientId, TestClientSecret, certificate, TestCasdoorOrganization, TestCasdoorApplication)
+
+	claims, err := client.ParseJwtToken(getTestJwtToken(t, privateKey, "alice", time.Now().Add(time.Hour)))
+	if err != nil {
+		t.Fatalf("Failed to parse token: %v", err)
+	}
+	if claims.Name != "alice" {
+		t.Fatalf("Unexpected claims: %+v", claims)
+	}
+
+	_, err = client.ParseJwtToken(getTestJwtToken(t, privateKey, "alice", time.Now().Add(-time.Hour)))
+	if err == nil {
+		t.Fatalf("Expected an expired token to be rejected")
+	}
+
+	// A rotated certificate replaces the cached key of the previous one
+	newPrivateKey, newCertificate := getTestJwtKeyPair(t)
+	client.Certificate = newCertificate
+	_, err = client.ParseJwtToken(getTestJwtToken(t, newPrivateKey, "alice", time.Now().Add(time.Hour)))
+	if err != nil {
+		t.Fatalf("Failed to parse token signed with the new certificate: %v", err)
+	}
+	_, err = client.ParseJwtToken(getTestJwtToken(t, privateKey, "alice", time.Now().Add(time.Hour)))
+	if err == nil {
+		t.Fatalf("Expected a token signed with the old certificate to be rejected")
+	}
+	if client.publicKeys.certificate != newCertificate || len(client.publicKeys.keys) != 1 {
+		t.Fatalf("Expected only the key of the new certificate to be cached, got %d keys", len(client.publicKeys.keys))
+	}
+
+	backend := &testJwtBackend{}
+	SetJwtBackend(backend)
+	defer SetJwtBackend(defaultJwtBackend{})
+
+	claims, err = client.ParseJwtToken("valid")
+	if err != nil || claims.Name != "alice" || backend.calls != 1 {
+		t.Fatalf("Custom backend was not used: %+v, %v", claims, err)
+	}
+	_, err = client.ParseJwtToken("invalid")
+	if err == nil {
+		t.Fatalf("Expected the custom backend's error to be returned")
+	}
+}
+
+func BenchmarkParseJwtToken(b *testing.B) {
+	privateKey, certificate := getTestJwtKeyPair(b)
+	client := NewClient(TestCasdoorEndpoint, TestClientId, TestClientSecret, certificate, TestCasdoorOrganization, TestCasdoorApplication)
+	token := getTestJwtToken(b, privateKey, "alice", time.Now().Add(time.Hour))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := client.ParseJwtToken(token)
+		if err != nil {
+			b.Fatalf("Failed to parse token: %v", err)
+		}
+	}
+}
@@ -14,10 +14,7 @@
 
 package casdoorsdk
 
-import (
-	"encoding/json"
-	"fmt"
-)
+import "fmt"
 
 type Ldap struct {
 	Id          string `xorm:"varchar(100) notnull pk" json:"id"`
@@ -89,7 +86,7 @@ func (c *Client) GetLdaps() ([]*Ldap, error) {
 	}
 
 	var ldaps []*Ldap
-	err = json.Unmarshal(bytes, &ldaps)
+	err = jsonCodec.Unmarshal(bytes, &ldaps)
 	if err != nil {
 		return nil, err
 	}
@@ -109,7 +106,7 @@ func (c *Client) GetLdap(id string) (*Ldap, error) {
 	}
 
 	var ldap *Ldap
-	err = json.Unmarshal(bytes, &ldap)
+	err = jsonCodec.Unmarshal(bytes, &ldap)
 	if err != nil {
 		return nil, err
 	}
@@ -142,7 +139,7 @@ func (c *Client) GetLdapUsers(id string) (*LdapUsersResponse, error) {
 	}
 
 	var ldapUsersResponse *LdapUsersResponse
-	err = json.Unmarshal(bytes, &ldapUsersResponse)
+	err = jsonCodec.Unmarshal(bytes, &ldapUsersResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -154,7 +151,7 @@ func (c *Client) SyncLdapUsers(id string, users []*LdapUser) (*SyncLdapUsersResp
 		"id": fmt.Sprintf("%s/%s", c.OrganizationName, id),
 	}
 
-	postBytes, err := json.Marshal(users)
+	postBytes, err := jsonCodec.Marshal(users)
 	if err != nil {
 		return nil, err
 	}
@@ -164,13 +161,13 @@ func (c *Client) SyncLdapUsers(id string, users []*LdapUser) (*SyncLdapUsersResp
 		return nil, err
 	}
 
-	dataBytes, err := json.Marshal(resp.Data)
+	dataBytes, err := jsonCodec.Marshal(resp.Data)
 	if err != nil {
 		return nil, err
 	}
 
 	var syncLdapUsersResponse *SyncLdapUsersResponse
-	err = json.Unmarshal(dataBytes, &syncLdapUsersResponse)
+	err = jsonCodec.Unmarshal(dataBytes, &syncLdapUsersResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,100 @@
+// Copyright 2025 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// linkableProviderFields are the User fields holding the id of a linked third-party account, named after the provider type.
+var linkableProviderFields = []string{
+	"GitHub", "Google", "QQ", "WeChat", "Facebook", "DingTalk", "Weibo", "Gitee",
+	"LinkedIn", "Wecom", "Lark", "Gitlab", "Adfs", "Baidu", "Alipay", "Casdoor",
+	"Infoflow", "Apple", "AzureAD", "Slack", "Steam", "Bilibili", "Okta", "Douyin",
+	"Line", "Amazon", "Auth0", "BattleNet", "Bitbucket", "Box", "CloudFoundry", "Dailymotion",
+	"Deezer", "DigitalOcean", "Discord", "Dropbox", "EveOnline", "Fitbit", "Gitea", "Heroku",
+	"InfluxCloud", "Instagram", "Intercom", "Kakao", "Lastfm", "Mailru", "Meetup", "MicrosoftOnline",
+	"Naver", "Nextcloud", "OneDrive", "Oura", "Patreon", "Paypal", "SalesForce", "Shopify",
+	"Soundcloud", "Spotify", "Strava", "Stripe", "TikTok", "Tumblr", "Twitch", "Twitter",
+	"Typetalk", "Uber", "VK", "Wepay", "Xero", "Yahoo", "Yammer", "Yandex",
+	"Zoom", "MetaMask", "Web3Onboard", "Custom",
+}
+
+// LinkedAccount is a third-party account linked to a user.
+type LinkedAccount struct {
+	ProviderType string `json:"providerType"`
+	Id           string `json:"id"`
+}
+
+// getProviderField returns the User field that stores the linked account id for the provider type, e.g. "GitHub" or "WeCom".
+func getProviderField(user *User, providerType string) (reflect.Value, reflect.StructField, error) {
+	for _, name := range linkableProviderFields {
+		if strings.EqualFold(name, providerType) {
+			field, _ := reflect.TypeOf(user).Elem().FieldByName(name)
+			return reflect.ValueOf(user).Elem().FieldByName(name), field, nil
+		}
+	}
+
+	return reflect.Value{}, reflect.StructField{}, fmt.Errorf("unsupported provider type: %s", providerType)
+}
+
+// GetLinkedAccounts returns the third-party accounts linked to the user.
+func (u User) GetLinkedAccounts() []*LinkedAccount {
+	accounts := []*LinkedAccount{}
+	value := reflect.ValueOf(u)
+	for _, name := range linkableProviderFields {
+		id := value.FieldByName(name).String()
+		if id != "" {
+			accounts = append(accounts, &LinkedAccount{ProviderType: name, Id: id})
+		}
+	}
+	return accounts
+}
+
+// GetUserLinkedAccounts gets the user and returns the third-party accounts linked to it.
+func (c *Client) GetUserLinkedAccounts(name string) ([]*LinkedAccount, error) {
+	user, err := c.GetUser(name)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, fmt.Errorf("user not found: %s", name)
+	}
+
+	return user.GetLinkedAccounts(), nil
+}
+
+// LinkProvider links the third-party account with the given id to the user. Only the provider's column is updated.
+func (c *Client) LinkProvider(user *User, providerType string, providerUserId string) (bool, error) {
+	return c.setProviderColumn(user, providerType, providerUserId)
+}
+
+// UnlinkProvider unlinks the user's account of the provider type by clearing the provider's column.
+func (c *Client) UnlinkProvider(user *User, providerType string) (bool, error) {
+	return c.setProviderColumn(user, providerType, "")
+}
+
+func (c *Client) setProviderColumn(user *User, providerType string, providerUserId string) (bool, error) {
+	value, field, err := getProviderField(user, providerType)
+	if err != nil {
+		return false, err
+	}
+
+	value.SetString(providerUserId)
+	column := strings.Split(field.Tag.Get("json"), ",")[0]
+	return c.UpdateUserForColumns(user, []string{column})
+}
@@ -0,0 +1,94 @@
+// Copyright 2025 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestLinkedAccounts(t *testing.T) {
+	user := &User{Owner: "casbin", Name: "alice", GitHub: "1001"}
+
+	value, field, err := getProviderField(user, "WeCom")
+	if err != nil {
+		t.Fatalf("Failed to get provider field: %v", err)
+	}
+	if field.Tag.Get("json") != "wecom" {
+		t.Fatalf("Unexpected provider field: %s", field.Name)
+	}
+	value.SetString("2002")
+
+	_, _, err = getProviderField(user, "Unknown")
+	if err == nil {
+		t.Fatalf("Expected an error for an unsupported provider type")
+	}
+
+	accounts := user.GetLinkedAccounts()
+	if len(accounts) != 2 {
+		t.Fatalf("Expected 2 linked accounts, got %d", len(accounts))
+	}
+	if accounts[0].ProviderType != "GitHub" || accounts[0].Id != "1001" || accounts[1].ProviderType != "Wecom" || accounts[1].Id != "2002" {
+		t.Fatalf("Unexpected linked accounts: %+v, %+v", accounts[0], accounts[1])
+	}
+}
+
+func TestLinkProvider(t *testing.T) {
+	queries := []url.Values{}
+	users := []User{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/update-user" {
+			t.Errorf("Unexpected request: %s", r.URL.Path)
+		}
+		var user User
+		json.NewDecoder(r.Body).Decode(&user)
+		queries = append(queries, r.URL.Query())
+		users = append(users, user)
+		w.Write([]byte(`{"status":"ok","msg":"","data":"Affected"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, TestClientId, TestClientSecret, TestJwtPublicKey, TestCasdoorOrganization, TestCasdoorApplication)
+	user := &User{Owner: "casbin", Name: "alice"}
+
+	affected, err := client.LinkProvider(user, "github", "1001")
+	if err != nil || !affected {
+		t.Fatalf("Failed to link provider: %v", err)
+	}
+	affected, err = client.UnlinkProvider(user, "GitHub")
+	if err != nil || !affected {
+		t.Fatalf("Failed to unlink provider: %v", err)
+	}
+
+	if len(queries) != 2 {
+		t.Fatalf("Expected 2 requests, got %d", len(queries))
+	}
+	for _, query := range queries {
+		if query.Get("id") != "casbin/alice" || query.Get("columns") != "github" {
+			t.Fatalf("Unexpected query: %v", query)
+		}
+	}
+	if users[0].GitHub != "1001" || users[1].GitHub != "" || user.GitHub != "" {
+		t.Fatalf("Unexpected linked ids: %q, %q", users[0].GitHub, users[1].GitHub)
+	}
+
+	_, err = client.UnlinkProvider(user, "Unknown")
+	if err == nil || len(queries) != 2 {
+		t.Fatalf("Expected an unsupported provider type to fail without a request")
+	}
+}
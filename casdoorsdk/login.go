@@ -0,0 +1,220 @@
+// Copyright 2025 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !tinygo
+
+package casdoorsdk
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"golang.org/x/oauth2"
+)
+
+const (
+	// LoginStatusNextMfa is returned as the data of an "ok" login response when the password
+	// was accepted but the user still has to pass one of their MFA challenges.
+	LoginStatusNextMfa = "NextMfa"
+	// LoginStatusRequiredMfa is returned as the data of an "ok" login response when the
+	// organization requires MFA but the user has not set it up yet.
+	LoginStatusRequiredMfa = "RequiredMfa"
+)
+
+// LoginForm has the same fields as the password sign-in part of https://github.com/casdoor/casdoor/blob/master/form/auth.go
+type LoginForm struct {
+	// Type is the kind of login, "code" returns an authorization code to exchange with GetOAuthToken.
+	Type         string `json:"type"`
+	SigninMethod string `json:"signinMethod"`
+	Organization string `json:"organization"`
+	Application  string `json:"application"`
+	Username     string `json:"username"`
+	Password     string `json:"password"`
+	AutoSignin   bool   `json:"autoSignin"`
+	Language     string `json:"language,omitempty"`
+
+	RedirectUri string `json:"redirectUri,omitempty"`
+	State       string `json:"state,omitempty"`
+	Nonce       string `json:"nonce,omitempty"`
+
+	CaptchaType  string `json:"captchaType,omitempty"`
+	CaptchaToken string `json:"captchaToken,omitempty"`
+	ClientSecret string `json:"clientSecret,omitempty"`
+
+	MfaType      string `json:"mfaType,omitempty"`
+	Passcode     string `json:"passcode,omitempty"`
+	RecoveryCode string `json:"recoveryCode,omitempty"`
+
+	// SessionCookies are sent with the request. Login sets them when MFA is pending,
+	// because the server ties the MFA step to the session of the first call.
+	SessionCookies []*http.Cookie `json:"-"`
+}
+
+// LoginResponse is the result of Login.
+type LoginResponse struct {
+	// Status is "ok" on success, or LoginStatusNextMfa / LoginStatusRequiredMfa when MFA is pending.
+	// The server reports both MFA states as an "ok" response whose data is the state name.
+	Status string
+	// Code is the authorization code returned by a successful login of type "code".
+	Code string
+	// MfaProps lists the MFA methods the user can verify with when Status is LoginStatusNextMfa.
+	MfaProps []*MfaProps
+}
+
+// IsMfaRequired returns true if the login has to be repeated with an MFA passcode or recovery code.
+func (r *LoginResponse) IsMfaRequired() bool {
+	return r.Status == LoginStatusNextMfa || r.Status == LoginStatusRequiredMfa
+}
+
+// Login signs a user in through the /api/login endpoint, so trusted first-party backends can build
+// their own login UI without redirecting to Casdoor. Empty Type, SigninMethod, Organization and
+// Application fields default to "code", "Password" and the client's organization and application.
+// When MFA is pending, submit the same form again with MfaType and Passcode (or RecoveryCode) set,
+// it carries the session cookies of the first call.
+func (c *Client) Login(form *LoginForm) (*LoginResponse, error) {
+	if form.Type == "" {
+		form.Type = "code"
+	}
+	if form.SigninMethod == "" {
+		form.SigninMethod = "Password"
+	}
+	if form.Organization == "" {
+		form.Organization = c.OrganizationName
+	}
+	if form.Application == "" {
+		form.Application = c.ApplicationName
+	}
+
+	queryMap := map[string]string{}
+	if form.Type == "code" {
+		queryMap = map[string]string{
+			"clientId":     c.ClientId,
+			"responseType": "code",
+			"redirectUri":  form.RedirectUri,
+			"scope":        "read",
+			"state":        form.State,
+			"nonce":        form.Nonce,
+		}
+	}
+
+	postBytes, err := jsonCodec.Marshal(form)
+	if err != nil {
+		return nil, err
+	}
+
+	respBytes, cookies, err := c.doLoginRequest(c.GetUrl("login", queryMap), postBytes, form.SessionCookies)
+	if err != nil {
+		return nil, err
+	}
+
+	var response Response
+	err = jsonCodec.Unmarshal(respBytes, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.Status != "ok" {
+		return nil, errors.New(response.Msg)
+	}
+
+	loginResp := &LoginResponse{Status: response.Status}
+	data, _ := response.Data.(string)
+	switch data {
+	case LoginStatusNextMfa, LoginStatusRequiredMfa:
+		loginResp.Status = data
+		if response.Data2 != nil {
+			dataBytes, err := jsonCodec.Marshal(response.Data2)
+			if err != nil {
+				return nil, err
+			}
+
+			err = jsonCodec.Unmarshal(dataBytes, &loginResp.MfaProps)
+			if err != nil {
+				return nil, errors.New("response data format is incorrect")
+			}
+		}
+		if len(cookies) != 0 {
+			form.SessionCookies = cookies
+		}
+	default:
+		loginResp.Code = data
+	}
+
+	return loginResp, nil
+}
+
+// doLoginRequest is like DoPostBytesRaw, but sends the given cookies and returns the ones set by the server.
+func (c *Client) doLoginRequest(url string, postBytes []byte, cookies []*http.Cookie) ([]byte, []*http.Cookie, error) {
+	req, err := http.NewRequestWithContext(c.getContext(), "POST", url, bytes.NewReader(postBytes))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req.SetBasicAuth(c.ClientId, c.ClientSecret)
+	req.Header.Set("Content-Type", "text/plain;charset=UTF-8")
+	for _, cookie := range cookies {
+		req.AddCookie(cookie)
+	}
+
+	c.setCustomHeaders(req)
+
+	resp, err := c.doHttpRequest(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+
+	respBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusForbidden {
+		return nil, nil, fmt.Errorf("status code: %d, status: %s, body: %s", resp.StatusCode, resp.Status, string(respBytes))
+	}
+
+	return respBytes, resp.Cookies(), nil
+}
+
+// GetGuestToken creates a guest user and signs it in, returning OAuth tokens for it.
+// The redirectUri must be one of the application's allowed redirect URLs.
+func (c *Client) GetGuestToken(redirectUri string) (*User, *oauth2.Token, error) {
+	user, password, err := c.CreateGuestUser()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := c.Login(&LoginForm{
+		Username:    user.Name,
+		Password:    password,
+		RedirectUri: redirectUri,
+		State:       c.ApplicationName,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if resp.Code == "" {
+		return nil, nil, errors.New("guest login did not return an authorization code")
+	}
+
+	token, err := c.GetOAuthToken(resp.Code, c.ApplicationName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return user, token, nil
+}
@@ -0,0 +1,80 @@
+// Copyright 2025 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestLogin(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var form LoginForm
+		if err := json.NewDecoder(r.Body).Decode(&form); err != nil {
+			t.Errorf("Failed to decode login form: %v", err)
+		}
+		if r.URL.Query().Get("clientId") != TestClientId || form.Application != TestCasdoorApplication {
+			t.Errorf("Login request is missing the client defaults")
+		}
+
+		if form.Passcode == "" {
+			http.SetCookie(w, &http.Cookie{Name: "casdoor_session_id", Value: "session-1"})
+			w.Write([]byte(`{"status":"ok","msg":"","data":"NextMfa","data2":[{"enabled":true,"isPreferred":true,"mfaType":"app"}]}`))
+			return
+		}
+
+		cookie, err := r.Cookie("casdoor_session_id")
+		if err != nil || cookie.Value != "session-1" {
+			w.Write([]byte(`{"status":"error","msg":"Please sign in first"}`))
+		} else if form.Passcode == "123456" {
+			w.Write([]byte(`{"status":"ok","msg":"","data":"code-1"}`))
+		} else {
+			w.Write([]byte(`{"status":"error","msg":"Invalid passcode"}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, TestClientId, TestClientSecret, TestJwtPublicKey, TestCasdoorOrganization, TestCasdoorApplication)
+
+	form := &LoginForm{Username: "alice", Password: "123"}
+	resp, err := client.Login(form)
+	if err != nil {
+		t.Fatalf("Failed to login: %v", err)
+	}
+	if !resp.IsMfaRequired() || resp.Code != "" || len(resp.MfaProps) != 1 || resp.MfaProps[0].MfaType != APP {
+		t.Fatalf("Expected an MFA challenge, got: %+v", resp)
+	}
+	if len(form.SessionCookies) != 1 {
+		t.Fatalf("Expected the session cookie to be kept in the form, got: %v", form.SessionCookies)
+	}
+
+	form.MfaType = resp.MfaProps[0].MfaType
+	form.Passcode = "000000"
+	_, err = client.Login(form)
+	if err == nil || err.Error() != "Invalid passcode" {
+		t.Fatalf("Expected the server error to be returned, got: %v", err)
+	}
+
+	form.Passcode = "123456"
+	resp, err = client.Login(form)
+	if err != nil {
+		t.Fatalf("Failed to login: %v", err)
+	}
+	if resp.IsMfaRequired() || resp.Code != "code-1" {
+		t.Fatalf("Unexpected login response: %+v", resp)
+	}
+}
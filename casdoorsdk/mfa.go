@@ -14,10 +14,6 @@
 
 package casdoorsdk
 
-import (
-	"encoding/json"
-)
-
 type MfaType string
 
 const (
@@ -61,7 +57,7 @@ func (c *Client) Initiate(owner, mfaType, name string) (*MfaInitiateResponse, er
 		Name:    name,
 	}
 
-	postBytes, err := json.Marshal(mfaReq)
+	postBytes, err := jsonCodec.Marshal(mfaReq)
 	if err != nil {
 		return nil, err
 	}
@@ -76,11 +72,11 @@ func (c *Client) Initiate(owner, mfaType, name string) (*MfaInitiateResponse, er
 	mfaResp.Msg = resp.Msg
 
 	if resp.Data != nil {
-		dataBytes, err := json.Marshal(resp.Data)
+		dataBytes, err := jsonCodec.Marshal(resp.Data)
 		if err != nil {
 			return nil, err
 		}
-		err = json.Unmarshal(dataBytes, &mfaResp.Data)
+		err = jsonCodec.Unmarshal(dataBytes, &mfaResp.Data)
 		if err != nil {
 			return nil, err
 		}
@@ -98,7 +94,7 @@ func (c *Client) Verify(owner, mfaType, name, secret, passcode string) (*MfaVeri
 		"passcode": passcode,
 	}
 
-	postBytes, err := json.Marshal(reqData)
+	postBytes, err := jsonCodec.Marshal(reqData)
 	if err != nil {
 		return nil, err
 	}
@@ -129,7 +125,7 @@ func (c *Client) Enable(owner, mfaType, name, secret string, recoveryCode string
 		RecoveryCode: recoveryCode,
 	}
 
-	postBytes, err := json.Marshal(mfaReq)
+	postBytes, err := jsonCodec.Marshal(mfaReq)
 	if err != nil {
 		return nil, err
 	}
@@ -139,13 +135,13 @@ func (c *Client) Enable(owner, mfaType, name, secret string, recoveryCode string
 		return nil, err
 	}
 
-	dataBytes, err := json.Marshal(resp)
+	dataBytes, err := jsonCodec.Marshal(resp)
 	if err != nil {
 		return nil, err
 	}
 
 	var mfaResp MfaVerifyResponse
-	err = json.Unmarshal(dataBytes, &mfaResp)
+	err = jsonCodec.Unmarshal(dataBytes, &mfaResp)
 	if err != nil {
 		return nil, err
 	}
@@ -161,7 +157,7 @@ func (c *Client) SetPreferred(owner, mfaType, name, secret string) error {
 		Secret:  secret,
 	}
 
-	postBytes, err := json.Marshal(mfaReq)
+	postBytes, err := jsonCodec.Marshal(mfaReq)
 	if err != nil {
 		return err
 	}
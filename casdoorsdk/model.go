@@ -15,7 +15,6 @@
 package casdoorsdk
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
@@ -65,7 +64,7 @@ func (c *Client) GetModels() ([]*Model, error) {
 	}
 
 	var models []*Model
-	err = json.Unmarshal(bytes, &models)
+	err = jsonCodec.Unmarshal(bytes, &models)
 	if err != nil {
 		return nil, err
 	}
@@ -84,13 +83,13 @@ func (c *Client) GetPaginationModels(p int, pageSize int, queryMap map[string]st
 		return nil, 0, err
 	}
 
-	dataBytes, err := json.Marshal(response.Data)
+	dataBytes, err := jsonCodec.Marshal(response.Data)
 	if err != nil {
 		return nil, 0, err
 	}
 
 	var models []*Model
-	err = json.Unmarshal(dataBytes, &models)
+	err = jsonCodec.Unmarshal(dataBytes, &models)
 	if err != nil {
 		return nil, 0, errors.New("response data format is incorrect")
 	}
@@ -111,7 +110,7 @@ func (c *Client) GetModel(name string) (*Model, error) {
 	}
 
 	var model *Model
-	err = json.Unmarshal(bytes, &model)
+	err = jsonCodec.Unmarshal(bytes, &model)
 	if err != nil {
 		return nil, err
 	}
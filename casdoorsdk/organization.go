@@ -14,10 +14,7 @@
 
 package casdoorsdk
 
-import (
-	"encoding/json"
-	"fmt"
-)
+import "fmt"
 
 type AccountItem struct {
 	Name       string `json:"name"`
@@ -100,7 +97,7 @@ func (c *Client) GetOrganization(name string) (*Organization, error) {
 	}
 
 	var organization *Organization
-	err = json.Unmarshal(bytes, &organization)
+	err = jsonCodec.Unmarshal(bytes, &organization)
 	if err != nil {
 		return nil, err
 	}
@@ -120,7 +117,7 @@ func (c *Client) GetOrganizations() ([]*Organization, error) {
 	}
 
 	var organizations []*Organization
-	err = json.Unmarshal(bytes, &organizations)
+	err = jsonCodec.Unmarshal(bytes, &organizations)
 	if err != nil {
 		return nil, err
 	}
@@ -140,7 +137,7 @@ func (c *Client) GetOrganizationNames() ([]*Organization, error) {
 	}
 
 	var organizationNames []*Organization
-	err = json.Unmarshal(bytes, &organizationNames)
+	err = jsonCodec.Unmarshal(bytes, &organizationNames)
 	if err != nil {
 		return nil, err
 	}
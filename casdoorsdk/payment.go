@@ -15,7 +15,6 @@
 package casdoorsdk
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
@@ -72,7 +71,7 @@ func (c *Client) GetPayments() ([]*Payment, error) {
 	}
 
 	var payments []*Payment
-	err = json.Unmarshal(bytes, &payments)
+	err = jsonCodec.Unmarshal(bytes, &payments)
 	if err != nil {
 		return nil, err
 	}
@@ -91,13 +90,13 @@ func (c *Client) GetPaginationPayments(p int, pageSize int, queryMap map[string]
 		return nil, 0, err
 	}
 
-	dataBytes, err := json.Marshal(response.Data)
+	dataBytes, err := jsonCodec.Marshal(response.Data)
 	if err != nil {
 		return nil, 0, err
 	}
 
 	var payments []*Payment
-	err = json.Unmarshal(dataBytes, &payments)
+	err = jsonCodec.Unmarshal(dataBytes, &payments)
 	if err != nil {
 		return nil, 0, errors.New("response data format is incorrect")
 	}
@@ -118,7 +117,7 @@ func (c *Client) GetPayment(name string) (*Payment, error) {
 	}
 
 	var payment *Payment
-	err = json.Unmarshal(bytes, &payment)
+	err = jsonCodec.Unmarshal(bytes, &payment)
 	if err != nil {
 		return nil, err
 	}
@@ -140,7 +139,7 @@ func (c *Client) GetUserPayments(userName string) ([]*Payment, error) {
 	}
 
 	var payments []*Payment
-	err = json.Unmarshal(bytes, &payments)
+	err = jsonCodec.Unmarshal(bytes, &payments)
 	if err != nil {
 		return nil, err
 	}
@@ -184,13 +183,13 @@ func (c *Client) PlaceOrder(productName string, providerName string, userName st
 		return nil, err
 	}
 
-	paymentJson, err := json.Marshal(resp.Data)
+	paymentJson, err := jsonCodec.Marshal(resp.Data)
 	if err != nil {
 		return nil, err
 	}
 
 	var payment Payment
-	err = json.Unmarshal(paymentJson, &payment)
+	err = jsonCodec.Unmarshal(paymentJson, &payment)
 	if err != nil {
 		return nil, err
 	}
@@ -209,13 +208,13 @@ func (c *Client) PayOrder(paymentName string, providerName string) (*Payment, er
 		return nil, err
 	}
 
-	paymentJson, err := json.Marshal(resp.Data)
+	paymentJson, err := jsonCodec.Marshal(resp.Data)
 	if err != nil {
 		return nil, err
 	}
 
 	var payment Payment
-	err = json.Unmarshal(paymentJson, &payment)
+	err = jsonCodec.Unmarshal(paymentJson, &payment)
 	if err != nil {
 		return nil, err
 	}
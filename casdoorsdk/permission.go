@@ -15,7 +15,6 @@
 package casdoorsdk
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
@@ -60,7 +59,7 @@ func (c *Client) GetPermissions() ([]*Permission, error) {
 	}
 
 	var permissions []*Permission
-	err = json.Unmarshal(bytes, &permissions)
+	err = jsonCodec.Unmarshal(bytes, &permissions)
 	if err != nil {
 		return nil, err
 	}
@@ -80,7 +79,7 @@ func (c *Client) GetPermissionsByRole(name string) ([]*Permission, error) {
 	}
 
 	var permissions []*Permission
-	err = json.Unmarshal(bytes, &permissions)
+	err = jsonCodec.Unmarshal(bytes, &permissions)
 	if err != nil {
 		return nil, err
 	}
@@ -99,13 +98,13 @@ func (c *Client) GetPaginationPermissions(p int, pageSize int, queryMap map[stri
 		return nil, 0, err
 	}
 
-	dataBytes, err := json.Marshal(response.Data)
+	dataBytes, err := jsonCodec.Marshal(response.Data)
 	if err != nil {
 		return nil, 0, err
 	}
 
 	var permissions []*Permission
-	err = json.Unmarshal(dataBytes, &permissions)
+	err = jsonCodec.Unmarshal(dataBytes, &permissions)
 	if err != nil {
 		return nil, 0, errors.New("response data format is incorrect")
 	}
@@ -126,7 +125,7 @@ func (c *Client) GetPermission(name string) (*Permission, error) {
 	}
 
 	var permission *Permission
-	err = json.Unmarshal(bytes, &permission)
+	err = jsonCodec.Unmarshal(bytes, &permission)
 	if err != nil {
 		return nil, err
 	}
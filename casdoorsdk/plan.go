@@ -15,7 +15,6 @@
 package casdoorsdk
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
@@ -53,7 +52,7 @@ func (c *Client) GetPlans() ([]*Plan, error) {
 	}
 
 	var plans []*Plan
-	err = json.Unmarshal(bytes, &plans)
+	err = jsonCodec.Unmarshal(bytes, &plans)
 	if err != nil {
 		return nil, err
 	}
@@ -72,13 +71,13 @@ func (c *Client) GetPaginationPlans(p int, pageSize int, queryMap map[string]str
 		return nil, 0, err
 	}
 
-	dataBytes, err := json.Marshal(response.Data)
+	dataBytes, err := jsonCodec.Marshal(response.Data)
 	if err != nil {
 		return nil, 0, err
 	}
 
 	var plans []*Plan
-	err = json.Unmarshal(dataBytes, &plans)
+	err = jsonCodec.Unmarshal(dataBytes, &plans)
 	if err != nil {
 		return nil, 0, errors.New("response data format is incorrect")
 	}
@@ -99,7 +98,7 @@ func (c *Client) GetPlan(name string) (*Plan, error) {
 	}
 
 	var plan *Plan
-	err = json.Unmarshal(bytes, &plan)
+	err = jsonCodec.Unmarshal(bytes, &plan)
 	if err != nil {
 		return nil, err
 	}
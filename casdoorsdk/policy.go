@@ -14,10 +14,7 @@
 
 package casdoorsdk
 
-import (
-	"encoding/json"
-	"fmt"
-)
+import "fmt"
 
 type CasbinRule struct {
 	Id    int64  `xorm:"pk autoincr"`
@@ -71,7 +68,7 @@ func (c *Client) GetPolicies(enforcerName string, adapterId string) ([]*CasbinRu
 	}
 
 	var policies []*CasbinRule
-	err = json.Unmarshal(bytes, &policies)
+	err = jsonCodec.Unmarshal(bytes, &policies)
 	if err != nil {
 		return nil, err
 	}
@@ -92,7 +89,7 @@ func (c *Client) GetFilteredPolicies(enforcerId string, filters []*PolicyFilter)
 	}
 
 	// Convert filters to JSON
-	postBytes, err := json.Marshal(filters)
+	postBytes, err := jsonCodec.Marshal(filters)
 	if err != nil {
 		return nil, err
 	}
@@ -104,13 +101,13 @@ func (c *Client) GetFilteredPolicies(enforcerId string, filters []*PolicyFilter)
 	}
 
 	// Extract data from response
-	res, err := json.Marshal(resp.Data)
+	res, err := jsonCodec.Marshal(resp.Data)
 	if err != nil {
 		return nil, err
 	}
 
 	var policies []*CasbinRule
-	err = json.Unmarshal(res, &policies)
+	err = jsonCodec.Unmarshal(res, &policies)
 	if err != nil {
 		return nil, err
 	}
@@ -15,7 +15,6 @@
 package casdoorsdk
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
@@ -55,7 +54,7 @@ func (c *Client) GetPricings() ([]*Pricing, error) {
 	}
 
 	var pricings []*Pricing
-	err = json.Unmarshal(bytes, &pricings)
+	err = jsonCodec.Unmarshal(bytes, &pricings)
 	if err != nil {
 		return nil, err
 	}
@@ -74,13 +73,13 @@ func (c *Client) GetPaginationPricings(p int, pageSize int, queryMap map[string]
 		return nil, 0, err
 	}
 
-	dataBytes, err := json.Marshal(response.Data)
+	dataBytes, err := jsonCodec.Marshal(response.Data)
 	if err != nil {
 		return nil, 0, err
 	}
 
 	var pricings []*Pricing
-	err = json.Unmarshal(dataBytes, &pricings)
+	err = jsonCodec.Unmarshal(dataBytes, &pricings)
 	if err != nil {
 		return nil, 0, errors.New("response data format is incorrect")
 	}
@@ -101,7 +100,7 @@ func (c *Client) GetPricing(name string) (*Pricing, error) {
 	}
 
 	var pricing *Pricing
-	err = json.Unmarshal(bytes, &pricing)
+	err = jsonCodec.Unmarshal(bytes, &pricing)
 	if err != nil {
 		return nil, err
 	}
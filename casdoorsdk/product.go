@@ -15,7 +15,6 @@
 package casdoorsdk
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
@@ -58,7 +57,7 @@ func (c *Client) GetProducts() ([]*Product, error) {
 	}
 
 	var products []*Product
-	err = json.Unmarshal(bytes, &products)
+	err = jsonCodec.Unmarshal(bytes, &products)
 	if err != nil {
 		return nil, err
 	}
@@ -77,13 +76,13 @@ func (c *Client) GetPaginationProducts(p int, pageSize int, queryMap map[string]
 		return nil, 0, err
 	}
 
-	dataBytes, err := json.Marshal(response.Data)
+	dataBytes, err := jsonCodec.Marshal(response.Data)
 	if err != nil {
 		return nil, 0, err
 	}
 
 	var products []*Product
-	err = json.Unmarshal(dataBytes, &products)
+	err = jsonCodec.Unmarshal(dataBytes, &products)
 	if err != nil {
 		return nil, 0, errors.New("response data format is incorrect")
 	}
@@ -104,7 +103,7 @@ func (c *Client) GetProduct(name string) (*Product, error) {
 	}
 
 	var product *Product
-	err = json.Unmarshal(bytes, &product)
+	err = jsonCodec.Unmarshal(bytes, &product)
 	if err != nil {
 		return nil, err
 	}
@@ -15,7 +15,6 @@
 package casdoorsdk
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
@@ -67,6 +66,47 @@ type Provider struct {
 	EnablePkce             bool              `json:"enablePkce"`
 }
 
+func (c *Client) GetGlobalProviders() ([]*Provider, error) {
+	url := c.GetUrl("get-global-providers", nil)
+
+	bytes, err := c.DoGetBytes(url)
+	if err != nil {
+		return nil, err
+	}
+
+	var providers []*Provider
+	err = jsonCodec.Unmarshal(bytes, &providers)
+	if err != nil {
+		return nil, err
+	}
+	return providers, nil
+}
+
+func (c *Client) GetPaginationGlobalProviders(p int, pageSize int, queryMap map[string]string) ([]*Provider, int, error) {
+	queryMap["p"] = strconv.Itoa(p)
+	queryMap["pageSize"] = strconv.Itoa(pageSize)
+
+	url := c.GetUrl("get-global-providers", queryMap)
+
+	response, err := c.DoGetResponse(url)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	dataBytes, err := jsonCodec.Marshal(response.Data)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var providers []*Provider
+	err = jsonCodec.Unmarshal(dataBytes, &providers)
+	if err != nil {
+		return nil, 0, errors.New("response data format is incorrect")
+	}
+
+	return providers, int(response.Data2.(float64)), nil
+}
+
 func (c *Client) GetProviders() ([]*Provider, error) {
 	queryMap := map[string]string{
 		"owner": c.OrganizationName,
@@ -80,7 +120,7 @@ func (c *Client) GetProviders() ([]*Provider, error) {
 	}
 
 	var providers []*Provider
-	err = json.Unmarshal(bytes, &providers)
+	err = jsonCodec.Unmarshal(bytes, &providers)
 	if err != nil {
 		return nil, err
 	}
@@ -100,7 +140,7 @@ func (c *Client) GetProvider(name string) (*Provider, error) {
 	}
 
 	var provider *Provider
-	err = json.Unmarshal(bytes, &provider)
+	err = jsonCodec.Unmarshal(bytes, &provider)
 	if err != nil {
 		return nil, err
 	}
@@ -119,13 +159,13 @@ func (c *Client) GetPaginationProviders(p int, pageSize int, queryMap map[string
 		return nil, 0, err
 	}
 
-	dataBytes, err := json.Marshal(response.Data)
+	dataBytes, err := jsonCodec.Marshal(response.Data)
 	if err != nil {
 		return nil, 0, err
 	}
 
 	var providers []*Provider
-	err = json.Unmarshal(dataBytes, &providers)
+	err = jsonCodec.Unmarshal(dataBytes, &providers)
 	if err != nil {
 		return nil, 0, errors.New("response data format is incorrect")
 	}
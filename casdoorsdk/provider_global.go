@@ -14,6 +14,14 @@
 
 package casdoorsdk
 
+func GetGlobalProviders() ([]*Provider, error) {
+	return globalClient.GetGlobalProviders()
+}
+
+func GetPaginationGlobalProviders(p int, pageSize int, queryMap map[string]string) ([]*Provider, int, error) {
+	return globalClient.GetPaginationGlobalProviders(p, pageSize, queryMap)
+}
+
 func GetProviders() ([]*Provider, error) {
 	return globalClient.GetProviders()
 }
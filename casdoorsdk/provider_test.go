@@ -91,3 +91,26 @@ func TestProvider(t *testing.T) {
 		t.Fatalf("Failed to delete object, it's still retrievable")
 	}
 }
+
+func TestGetGlobalProviders(t *testing.T) {
+	server := getTestGlobalListServer(t, "get-global-providers")
+	defer server.Close()
+
+	client := NewClient(server.URL, TestClientId, TestClientSecret, TestJwtPublicKey, TestCasdoorOrganization, TestCasdoorApplication)
+
+	providers, err := client.GetGlobalProviders()
+	if err != nil {
+		t.Fatalf("Failed to get objects: %v", err)
+	}
+	if len(providers) != 2 || providers[0].Name != "a" {
+		t.Fatalf("Unexpected objects: %v", providers)
+	}
+
+	providers, count, err := client.GetPaginationGlobalProviders(2, 10, map[string]string{"field": "name", "value": "a"})
+	if err != nil {
+		t.Fatalf("Failed to get objects: %v", err)
+	}
+	if len(providers) != 2 || count != 25 {
+		t.Fatalf("Expected 2 objects of 25, got %d of %d", len(providers), count)
+	}
+}
@@ -15,7 +15,6 @@
 package casdoorsdk
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
@@ -58,7 +57,7 @@ func (c *Client) GetRecords() ([]*Record, error) {
 	}
 
 	var records []*Record
-	err = json.Unmarshal(bytes, &records)
+	err = jsonCodec.Unmarshal(bytes, &records)
 	if err != nil {
 		return nil, err
 	}
@@ -77,13 +76,13 @@ func (c *Client) GetPaginationRecords(p int, pageSize int, queryMap map[string]s
 		return nil, 0, err
 	}
 
-	dataBytes, err := json.Marshal(response.Data)
+	dataBytes, err := jsonCodec.Marshal(response.Data)
 	if err != nil {
 		return nil, 0, err
 	}
 
 	var records []*Record
-	err = json.Unmarshal(dataBytes, &records)
+	err = jsonCodec.Unmarshal(dataBytes, &records)
 	if err != nil {
 		return nil, 0, errors.New("response data format is incorrect")
 	}
@@ -104,7 +103,7 @@ func (c *Client) GetRecord(name string) (*Record, error) {
 	}
 
 	var record *Record
-	err = json.Unmarshal(bytes, &record)
+	err = jsonCodec.Unmarshal(bytes, &record)
 	if err != nil {
 		return nil, err
 	}
@@ -119,7 +118,7 @@ func (c *Client) AddRecord(record *Record) (bool, error) {
 		record.Organization = c.OrganizationName
 	}
 
-	postBytes, err := json.Marshal(record)
+	postBytes, err := jsonCodec.Marshal(record)
 	if err != nil {
 		return false, err
 	}
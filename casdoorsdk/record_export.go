@@ -0,0 +1,166 @@
+// Copyright 2025 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// Formats supported by ExportRecords.
+const (
+	// RecordExportFormatNdjson writes one JSON record per line.
+	RecordExportFormatNdjson = "ndjson"
+	// RecordExportFormatBulk writes an OpenSearch/Elasticsearch bulk index request body.
+	RecordExportFormatBulk = "bulk"
+)
+
+// RecordExportCheckpoint is the position of an export, which can be saved and passed back to resume it.
+// Page is counted in pages of PageSize records, so an export can only be resumed with the same page size.
+type RecordExportCheckpoint struct {
+	Page     int `json:"page"`
+	PageSize int `json:"pageSize"`
+	LastId   int `json:"lastId"`
+	Count    int `json:"count"`
+}
+
+// RecordExportOptions configures ExportRecords.
+type RecordExportOptions struct {
+	// Format is RecordExportFormatNdjson (the default) or RecordExportFormatBulk.
+	Format string
+	// Index is the target index name for RecordExportFormatBulk, defaults to "casdoor-records".
+	Index string
+	// PageSize is the number of records fetched per request, defaults to the checkpoint's page size or 100.
+	PageSize int
+	// QueryMap holds additional filters for the records query, such as "field" and "value".
+	QueryMap map[string]string
+	// Checkpoint resumes a previous export after the last record it wrote.
+	Checkpoint *RecordExportCheckpoint
+	// OnCheckpoint is called after each page has been written, e.g. to persist the checkpoint.
+	OnCheckpoint func(checkpoint RecordExportCheckpoint) error
+}
+
+// ExportRecords streams the organization's records page by page in ascending id order and writes them to w,
+// for shipping audit logs to SIEM pipelines. It returns the checkpoint after the last written record.
+// The options can be nil.
+func (c *Client) ExportRecords(w io.Writer, options *RecordExportOptions) (*RecordExportCheckpoint, error) {
+	if options == nil {
+		options = &RecordExportOptions{}
+	}
+
+	format := options.Format
+	if format == "" {
+		format = RecordExportFormatNdjson
+	}
+	if format != RecordExportFormatNdjson && format != RecordExportFormatBulk {
+		return nil, fmt.Errorf("unsupported record export format: %s", format)
+	}
+	index := options.Index
+	if index == "" {
+		index = "casdoor-records"
+	}
+	pageSize := options.PageSize
+	if pageSize <= 0 && options.Checkpoint != nil {
+		pageSize = options.Checkpoint.PageSize
+	}
+	if pageSize <= 0 {
+		pageSize = 100
+	}
+
+	checkpoint := RecordExportCheckpoint{Page: 1, PageSize: pageSize}
+	if options.Checkpoint != nil {
+		// Page numbers of another page size point to other records, resuming with them would skip records
+		if options.Checkpoint.PageSize != pageSize {
+			return nil, fmt.Errorf("record export checkpoint has page size %d, cannot resume with page size %d", options.Checkpoint.PageSize, pageSize)
+		}
+		checkpoint = *options.Checkpoint
+	}
+
+	bw := bufio.NewWriter(w)
+	for {
+		queryMap := map[string]string{}
+		for k, v := range options.QueryMap {
+			queryMap[k] = v
+		}
+		queryMap["sortField"] = "id"
+		queryMap["sortOrder"] = "ascend"
+
+		records, _, err := c.GetPaginationRecords(checkpoint.Page, pageSize, queryMap)
+		if err != nil {
+			return &checkpoint, err
+		}
+
+		for _, record := range records {
+			// Records of the resumed page that were written before the checkpoint
+			if record.Id <= checkpoint.LastId {
+				continue
+			}
+
+			err = writeExportedRecord(bw, format, index, record)
+			if err != nil {
+				return &checkpoint, err
+			}
+			checkpoint.LastId = record.Id
+			checkpoint.Count++
+		}
+
+		err = bw.Flush()
+		if err != nil {
+			return &checkpoint, err
+		}
+
+		isLastPage := len(records) < pageSize
+		if !isLastPage {
+			checkpoint.Page++
+		}
+
+		if options.OnCheckpoint != nil {
+			err = options.OnCheckpoint(checkpoint)
+			if err != nil {
+				return &checkpoint, err
+			}
+		}
+
+		if isLastPage {
+			return &checkpoint, nil
+		}
+	}
+}
+
+func writeExportedRecord(w *bufio.Writer, format string, index string, record *Record) error {
+	if format == RecordExportFormatBulk {
+		action := map[string]map[string]string{
+			"index": {
+				"_index": index,
+				"_id":    strconv.Itoa(record.Id),
+			},
+		}
+		actionBytes, err := jsonCodec.Marshal(action)
+		if err != nil {
+			return err
+		}
+		w.Write(actionBytes)
+		w.WriteByte('\n')
+	}
+
+	recordBytes, err := jsonCodec.Marshal(record)
+	if err != nil {
+		return err
+	}
+	w.Write(recordBytes)
+	return w.WriteByte('\n')
+}
@@ -0,0 +1,95 @@
+// Copyright 2025 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestExportRecords(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		p, _ := strconv.Atoi(r.URL.Query().Get("p"))
+		pageSize, _ := strconv.Atoi(r.URL.Query().Get("pageSize"))
+
+		records := []*Record{}
+		for id := (p-1)*pageSize + 1; id <= p*pageSize && id <= 5; id++ {
+			records = append(records, &Record{Id: id, Name: fmt.Sprintf("record_%d", id)})
+		}
+		json.NewEncoder(w).Encode(Response{Status: "ok", Data: records, Data2: 5})
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, TestClientId, TestClientSecret, TestJwtPublicKey, TestCasdoorOrganization, TestCasdoorApplication)
+
+	var buf bytes.Buffer
+	var checkpoints []RecordExportCheckpoint
+	checkpoint, err := client.ExportRecords(&buf, &RecordExportOptions{
+		Format:   RecordExportFormatBulk,
+		PageSize: 2,
+		OnCheckpoint: func(checkpoint RecordExportCheckpoint) error {
+			checkpoints = append(checkpoints, checkpoint)
+			return nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to export records: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 10 || lines[0] != `{"index":{"_id":"1","_index":"casdoor-records"}}` {
+		t.Fatalf("Unexpected bulk output: %s", buf.String())
+	}
+	if checkpoint.LastId != 5 || checkpoint.Count != 5 || len(checkpoints) != 3 {
+		t.Fatalf("Unexpected checkpoint: %+v, %d checkpoints", checkpoint, len(checkpoints))
+	}
+
+	// Resume in the middle of the second page
+	buf.Reset()
+	checkpoint, err = client.ExportRecords(&buf, &RecordExportOptions{
+		PageSize:   2,
+		Checkpoint: &RecordExportCheckpoint{Page: 2, PageSize: 2, LastId: 3, Count: 3},
+	})
+	if err != nil {
+		t.Fatalf("Failed to resume export: %v", err)
+	}
+
+	lines = strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 || !strings.Contains(lines[0], `"name":"record_4"`) || checkpoint.Count != 5 {
+		t.Fatalf("Unexpected resumed output: %s", buf.String())
+	}
+
+	// Page 2 of 5 records would start after record 5, skipping records 3 and 4
+	_, err = client.ExportRecords(&buf, &RecordExportOptions{
+		PageSize:   5,
+		Checkpoint: &RecordExportCheckpoint{Page: 2, PageSize: 2, LastId: 2, Count: 2},
+	})
+	if err == nil {
+		t.Fatalf("Expected resuming with another page size to fail")
+	}
+
+	// Without options, the defaults are used
+	buf.Reset()
+	checkpoint, err = client.ExportRecords(&buf, nil)
+	if err != nil || checkpoint.Count != 5 || checkpoint.PageSize != 100 {
+		t.Fatalf("Unexpected export with nil options: %+v, %v", checkpoint, err)
+	}
+}
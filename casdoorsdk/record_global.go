@@ -14,6 +14,8 @@
 
 package casdoorsdk
 
+import "io"
+
 func GetRecords() ([]*Record, error) {
 	return globalClient.GetRecords()
 }
@@ -29,3 +31,7 @@ func GetRecord(name string) (*Record, error) {
 func AddRecord(record *Record) (bool, error) {
 	return globalClient.AddRecord(record)
 }
+
+func ExportRecords(w io.Writer, options *RecordExportOptions) (*RecordExportCheckpoint, error) {
+	return globalClient.ExportRecords(w, options)
+}
@@ -15,7 +15,6 @@
 package casdoorsdk
 
 import (
-	"encoding/json"
 	"fmt"
 	"strconv"
 )
@@ -52,7 +51,7 @@ func (c *Client) GetResource(id string) (*Resource, error) {
 	}
 
 	var resource *Resource
-	err = json.Unmarshal(bytes, &resource)
+	err = jsonCodec.Unmarshal(bytes, &resource)
 	if err != nil {
 		return nil, err
 	}
@@ -82,7 +81,7 @@ func (c *Client) GetResources(owner, user, field, value, sortField, sortOrder st
 	}
 
 	var resources []*Resource
-	err = json.Unmarshal(bytes, &resources)
+	err = jsonCodec.Unmarshal(bytes, &resources)
 	if err != nil {
 		return nil, err
 	}
@@ -109,7 +108,7 @@ func (c *Client) GetPaginationResources(owner, user, field, value string, pageSi
 	}
 
 	var resources []*Resource
-	err = json.Unmarshal(bytes, &resources)
+	err = jsonCodec.Unmarshal(bytes, &resources)
 	if err != nil {
 		return nil, err
 	}
@@ -171,7 +170,7 @@ func (c *Client) DeleteResourceWithTag(resource *Resource, tag string) (bool, er
 		"tag": tag,
 	}
 
-	postBytes, err := json.Marshal(resource)
+	postBytes, err := jsonCodec.Marshal(resource)
 	if err != nil {
 		return false, err
 	}
@@ -15,7 +15,6 @@
 package casdoorsdk
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
@@ -49,7 +48,7 @@ func (c *Client) GetRoles() ([]*Role, error) {
 	}
 
 	var roles []*Role
-	err = json.Unmarshal(bytes, &roles)
+	err = jsonCodec.Unmarshal(bytes, &roles)
 	if err != nil {
 		return nil, err
 	}
@@ -68,13 +67,13 @@ func (c *Client) GetPaginationRoles(p int, pageSize int, queryMap map[string]str
 		return nil, 0, err
 	}
 
-	dataBytes, err := json.Marshal(response.Data)
+	dataBytes, err := jsonCodec.Marshal(response.Data)
 	if err != nil {
 		return nil, 0, err
 	}
 
 	var roles []*Role
-	err = json.Unmarshal(dataBytes, &roles)
+	err = jsonCodec.Unmarshal(dataBytes, &roles)
 	if err != nil {
 		return nil, 0, errors.New("response data format is incorrect")
 	}
@@ -95,7 +94,7 @@ func (c *Client) GetRole(name string) (*Role, error) {
 	}
 
 	var role *Role
-	err = json.Unmarshal(bytes, &role)
+	err = jsonCodec.Unmarshal(bytes, &role)
 	if err != nil {
 		return nil, err
 	}
@@ -15,7 +15,6 @@
 package casdoorsdk
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
@@ -48,7 +47,7 @@ func (c *Client) GetSessions() ([]*Session, error) {
 	}
 
 	var sessions []*Session
-	err = json.Unmarshal(bytes, &sessions)
+	err = jsonCodec.Unmarshal(bytes, &sessions)
 	if err != nil {
 		return nil, err
 	}
@@ -67,13 +66,13 @@ func (c *Client) GetPaginationSessions(p int, pageSize int, queryMap map[string]
 		return nil, 0, err
 	}
 
-	dataBytes, err := json.Marshal(response.Data)
+	dataBytes, err := jsonCodec.Marshal(response.Data)
 	if err != nil {
 		return nil, 0, err
 	}
 
 	var sessions []*Session
-	err = json.Unmarshal(dataBytes, &sessions)
+	err = jsonCodec.Unmarshal(dataBytes, &sessions)
 	if err != nil {
 		return nil, 0, errors.New("response data format is incorrect")
 	}
@@ -93,7 +92,7 @@ func (c *Client) GetSession(name string, application string) (*Session, error) {
 	}
 
 	var session *Session
-	err = json.Unmarshal(bytes, &session)
+	err = jsonCodec.Unmarshal(bytes, &session)
 	if err != nil {
 		return nil, err
 	}
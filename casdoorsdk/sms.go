@@ -14,8 +14,6 @@
 
 package casdoorsdk
 
-import "encoding/json"
-
 type smsForm struct {
 	Content   string   `json:"content"`
 	Receivers []string `json:"receivers"`
@@ -26,7 +24,7 @@ func (c *Client) SendSms(content string, receivers ...string) error {
 		Content:   content,
 		Receivers: receivers,
 	}
-	postBytes, err := json.Marshal(form)
+	postBytes, err := jsonCodec.Marshal(form)
 	if err != nil {
 		return err
 	}
@@ -43,7 +41,7 @@ func (c *Client) SendSmsByProvider(content string, provider string, receivers ..
 		Content:   content,
 		Receivers: receivers,
 	}
-	postBytes, err := json.Marshal(form)
+	postBytes, err := jsonCodec.Marshal(form)
 	if err != nil {
 		return err
 	}
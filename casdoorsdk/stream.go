@@ -0,0 +1,158 @@
+// Copyright 2025 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// streamListData sends a GET request to url and calls fn with a decoder positioned at each element
+// of the response's data array, so that huge lists are decoded one object at a time instead of being buffered.
+// Responses with the application/x-ndjson content type are decoded as one object per line.
+// Streaming always uses encoding/json, regardless of SetJsonCodec.
+func (c *Client) streamListData(url string, fn func(decoder *json.Decoder) error) error {
+	body, contentType, err := c.doGetStream(url)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	decoder := json.NewDecoder(body)
+	if strings.HasPrefix(contentType, "application/x-ndjson") {
+		for decoder.More() {
+			err = fn(decoder)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	return decodeResponseStream(decoder, fn)
+}
+
+// decodeResponseStream walks a Response object and streams the elements of its data array to fn.
+func decodeResponseStream(decoder *json.Decoder, fn func(decoder *json.Decoder) error) error {
+	err := expectDelim(decoder, '{')
+	if err != nil {
+		return err
+	}
+
+	status := ""
+	msg := ""
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+
+		switch token {
+		case "status":
+			err = decoder.Decode(&status)
+		case "msg":
+			err = decoder.Decode(&msg)
+		case "data":
+			if status != "" && status != "ok" {
+				return errors.New(msg)
+			}
+			err = decodeArrayStream(decoder, fn)
+		default:
+			var value json.RawMessage
+			err = decoder.Decode(&value)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if status != "ok" {
+		return errors.New(msg)
+	}
+	return nil
+}
+
+func decodeArrayStream(decoder *json.Decoder, fn func(decoder *json.Decoder) error) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	if token == nil {
+		return nil
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != '[' {
+		return fmt.Errorf("response data is not a list: %v", token)
+	}
+
+	for decoder.More() {
+		err = fn(decoder)
+		if err != nil {
+			return err
+		}
+	}
+
+	return expectDelim(decoder, ']')
+}
+
+func expectDelim(decoder *json.Decoder, expected json.Delim) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != expected {
+		return fmt.Errorf("unexpected token in response: %v", token)
+	}
+	return nil
+}
+
+// StreamUsers calls fn for each user of the organization as soon as it is decoded, instead of buffering the whole list
+// like GetUsers, which keeps memory usage flat for very large organizations. Returning an error from fn stops the stream.
+// The queryMap may hold filters such as "field" and "value", and can be nil.
+func (c *Client) StreamUsers(queryMap map[string]string, fn func(user *User) error) error {
+	params := map[string]string{}
+	for k, v := range queryMap {
+		params[k] = v
+	}
+	params["owner"] = c.OrganizationName
+
+	return c.streamListData(c.GetUrl("get-users", params), func(decoder *json.Decoder) error {
+		var user User
+		err := decoder.Decode(&user)
+		if err != nil {
+			return err
+		}
+		return fn(&user)
+	})
+}
+
+// StreamRecords calls fn for each record of the organization as soon as it is decoded, like StreamUsers.
+func (c *Client) StreamRecords(queryMap map[string]string, fn func(record *Record) error) error {
+	params := map[string]string{}
+	for k, v := range queryMap {
+		params[k] = v
+	}
+	params["owner"] = c.OrganizationName
+
+	return c.streamListData(c.GetUrl("get-records", params), func(decoder *json.Decoder) error {
+		var record Record
+		err := decoder.Decode(&record)
+		if err != nil {
+			return err
+		}
+		return fn(&record)
+	})
+}
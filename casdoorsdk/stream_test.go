@@ -0,0 +1,69 @@
+// Copyright 2025 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestStream(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/get-users":
+			w.Write([]byte(`{"status":"ok","msg":"","data":[{"owner":"built-in","name":"alice"},{"owner":"built-in","name":"bob"}],"data2":null}`))
+		case "/api/get-records":
+			w.Header().Set("Content-Type", "application/x-ndjson")
+			w.Write([]byte("{\"name\":\"1\"}\n{\"name\":\"2\"}\n{\"name\":\"3\"}\n"))
+		default:
+			w.Write([]byte(`{"status":"error","msg":"Unauthorized operation","data":null}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, TestClientId, TestClientSecret, TestJwtPublicKey, TestCasdoorOrganization, TestCasdoorApplication)
+
+	names := []string{}
+	err := client.StreamUsers(nil, func(user *User) error {
+		names = append(names, user.Name)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream users: %v", err)
+	}
+	if len(names) != 2 || names[0] != "alice" || names[1] != "bob" {
+		t.Fatalf("Unexpected users: %v", names)
+	}
+
+	count := 0
+	stop := errors.New("stop")
+	err = client.StreamRecords(nil, func(record *Record) error {
+		count++
+		if count == 2 {
+			return stop
+		}
+		return nil
+	})
+	if err != stop || count != 2 {
+		t.Fatalf("Returning an error should stop the stream, got %v after %d records", err, count)
+	}
+
+	err = client.streamListData(client.GetUrl("get-tokens", nil), nil)
+	if err == nil || err.Error() != "Unauthorized operation" {
+		t.Fatalf("Expected the response message as error, got %v", err)
+	}
+}
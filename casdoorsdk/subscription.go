@@ -15,7 +15,6 @@
 package casdoorsdk
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
@@ -65,7 +64,7 @@ func (c *Client) GetSubscriptions() ([]*Subscription, error) {
 	}
 
 	var subscriptions []*Subscription
-	err = json.Unmarshal(bytes, &subscriptions)
+	err = jsonCodec.Unmarshal(bytes, &subscriptions)
 	if err != nil {
 		return nil, err
 	}
@@ -84,13 +83,13 @@ func (c *Client) GetPaginationSubscriptions(p int, pageSize int, queryMap map[st
 		return nil, 0, err
 	}
 
-	dataBytes, err := json.Marshal(response.Data)
+	dataBytes, err := jsonCodec.Marshal(response.Data)
 	if err != nil {
 		return nil, 0, err
 	}
 
 	var subscriptions []*Subscription
-	err = json.Unmarshal(dataBytes, &subscriptions)
+	err = jsonCodec.Unmarshal(dataBytes, &subscriptions)
 	if err != nil {
 		return nil, 0, errors.New("response data format is incorrect")
 	}
@@ -111,7 +110,7 @@ func (c *Client) GetSubscription(name string) (*Subscription, error) {
 	}
 
 	var subscription *Subscription
-	err = json.Unmarshal(bytes, &subscription)
+	err = jsonCodec.Unmarshal(bytes, &subscription)
 	if err != nil {
 		return nil, err
 	}
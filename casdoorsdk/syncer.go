@@ -15,7 +15,6 @@
 package casdoorsdk
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
@@ -75,7 +74,7 @@ func (c *Client) GetSyncers() ([]*Syncer, error) {
 	}
 
 	var syncers []*Syncer
-	err = json.Unmarshal(bytes, &syncers)
+	err = jsonCodec.Unmarshal(bytes, &syncers)
 	if err != nil {
 		return nil, err
 	}
@@ -94,13 +93,13 @@ func (c *Client) GetPaginationSyncers(p int, pageSize int, queryMap map[string]s
 		return nil, 0, err
 	}
 
-	dataBytes, err := json.Marshal(response.Data)
+	dataBytes, err := jsonCodec.Marshal(response.Data)
 	if err != nil {
 		return nil, 0, err
 	}
 
 	var syncers []*Syncer
-	err = json.Unmarshal(dataBytes, &syncers)
+	err = jsonCodec.Unmarshal(dataBytes, &syncers)
 	if err != nil {
 		return nil, 0, errors.New("response data format is incorrect")
 	}
@@ -121,7 +120,7 @@ func (c *Client) GetSyncer(name string) (*Syncer, error) {
 	}
 
 	var syncer *Syncer
-	err = json.Unmarshal(bytes, &syncer)
+	err = jsonCodec.Unmarshal(bytes, &syncer)
 	if err != nil {
 		return nil, err
 	}
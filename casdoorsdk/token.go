@@ -15,7 +15,6 @@
 package casdoorsdk
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
@@ -69,7 +68,7 @@ func (c *Client) GetTokens() ([]*Token, error) {
 	}
 
 	var tokens []*Token
-	err = json.Unmarshal(bytes, &tokens)
+	err = jsonCodec.Unmarshal(bytes, &tokens)
 	if err != nil {
 		return nil, err
 	}
@@ -88,13 +87,13 @@ func (c *Client) GetPaginationTokens(p int, pageSize int, queryMap map[string]st
 		return nil, 0, err
 	}
 
-	dataBytes, err := json.Marshal(response.Data)
+	dataBytes, err := jsonCodec.Marshal(response.Data)
 	if err != nil {
 		return nil, 0, err
 	}
 
 	var tokens []*Token
-	err = json.Unmarshal(dataBytes, &tokens)
+	err = jsonCodec.Unmarshal(dataBytes, &tokens)
 	if err != nil {
 		return nil, 0, errors.New("response data format is incorrect")
 	}
@@ -115,7 +114,7 @@ func (c *Client) GetToken(name string) (*Token, error) {
 	}
 
 	var token *Token
-	err = json.Unmarshal(bytes, &token)
+	err = jsonCodec.Unmarshal(bytes, &token)
 	if err != nil {
 		return nil, err
 	}
@@ -160,7 +159,7 @@ func (c *Client) IntrospectToken(token, tokenTypeHint string) (result *Introspec
 		return
 	}
 
-	err = json.Unmarshal(respBytes, &result)
+	err = jsonCodec.Unmarshal(respBytes, &result)
 	if err != nil {
 		return
 	}
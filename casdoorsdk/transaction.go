@@ -15,7 +15,6 @@
 package casdoorsdk
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
@@ -54,7 +53,7 @@ func (c *Client) GetTransactions() ([]*Transaction, error) {
 	}
 
 	var transactions []*Transaction
-	err = json.Unmarshal(bytes, &transactions)
+	err = jsonCodec.Unmarshal(bytes, &transactions)
 	if err != nil {
 		return nil, err
 	}
@@ -73,13 +72,13 @@ func (c *Client) GetPaginationTransactions(p int, pageSize int, queryMap map[str
 		return nil, 0, err
 	}
 
-	dataBytes, err := json.Marshal(response.Data)
+	dataBytes, err := jsonCodec.Marshal(response.Data)
 	if err != nil {
 		return nil, 0, err
 	}
 
 	var transactions []*Transaction
-	err = json.Unmarshal(dataBytes, &transactions)
+	err = jsonCodec.Unmarshal(dataBytes, &transactions)
 	if err != nil {
 		return nil, 0, errors.New("response data format is incorrect")
 	}
@@ -100,7 +99,7 @@ func (c *Client) GetTransaction(name string) (*Transaction, error) {
 	}
 
 	var transaction *Transaction
-	err = json.Unmarshal(bytes, &transaction)
+	err = jsonCodec.Unmarshal(bytes, &transaction)
 	if err != nil {
 		return nil, err
 	}
@@ -121,7 +120,7 @@ func (c *Client) GetUserTransactions(userName string) ([]*Transaction, error) {
 	}
 
 	var transactions []*Transaction
-	err = json.Unmarshal(bytes, &transactions)
+	err = jsonCodec.Unmarshal(bytes, &transactions)
 	if err != nil {
 		return nil, err
 	}
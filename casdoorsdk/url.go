@@ -15,11 +15,32 @@
 package casdoorsdk
 
 import (
+	"encoding/base64"
 	"fmt"
 	"net/url"
 	"strings"
 )
 
+type providerAuthInfo struct {
+	endpoint string
+	scope    string
+}
+
+// providerAuthInfos has the same authorize endpoints and scopes as authInfo in https://github.com/casdoor/casdoor/blob/master/web/src/auth/Provider.js
+var providerAuthInfos = map[string]providerAuthInfo{
+	"Google":   {endpoint: "https://accounts.google.com/signin/oauth", scope: "profile email"},
+	"GitHub":   {endpoint: "https://github.com/login/oauth/authorize", scope: "user:email read:user"},
+	"QQ":       {endpoint: "https://graph.qq.com/oauth2.0/authorize", scope: "get_user_info"},
+	"WeChat":   {endpoint: "https://open.weixin.qq.com/connect/qrconnect", scope: "snsapi_login"},
+	"Facebook": {endpoint: "https://www.facebook.com/dialog/oauth", scope: "email,public_profile"},
+	"DingTalk": {endpoint: "https://login.dingtalk.com/oauth2/auth", scope: "openid"},
+	"Weibo":    {endpoint: "https://api.weibo.com/oauth2/authorize", scope: "email"},
+	"Gitee":    {endpoint: "https://gitee.com/oauth/authorize", scope: "user_info emails"},
+	"LinkedIn": {endpoint: "https://www.linkedin.com/oauth/v2/authorization", scope: "r_liteprofile r_emailaddress"},
+	"GitLab":   {endpoint: "https://gitlab.com/oauth/authorize", scope: "read_user profile"},
+	"Discord":  {endpoint: "https://discord.com/api/oauth2/authorize", scope: "identify email"},
+}
+
 func (c *Client) GetSignupUrl(enablePassword bool, redirectUri string) string {
 	// redirectUri can be empty string if enablePassword == true (only password enabled signup page is required)
 	if enablePassword {
@@ -53,3 +74,65 @@ func (c *Client) GetMyProfileUrl(accessToken string) string {
 	}
 	return fmt.Sprintf("%s/account%s", c.Endpoint, param)
 }
+
+// GetProviderSigninUrl returns the authorize URL that the Casdoor login page's button for the OAuth provider links to,
+// so custom login pages can render their own buttons. After the user authorizes at the provider, Casdoor signs them in
+// and redirects to redirectUri with an authorization code, like a login started with GetSigninUrl.
+// Custom (OIDC) providers use their CustomAuthUrl and Scopes.
+func (c *Client) GetProviderSigninUrl(provider *Provider, redirectUri string) (string, error) {
+	if provider.Category != "OAuth" {
+		return "", fmt.Errorf("provider %s is not an OAuth provider", provider.Name)
+	}
+	if provider.EnablePkce {
+		return "", fmt.Errorf("provider %s has PKCE enabled, which requires the Casdoor login page", provider.Name)
+	}
+
+	info, ok := providerAuthInfos[provider.Type]
+	if provider.Type == "Custom" {
+		info, ok = providerAuthInfo{endpoint: provider.CustomAuthUrl, scope: provider.Scopes}, provider.CustomAuthUrl != ""
+	}
+	if !ok {
+		return "", fmt.Errorf("unsupported provider type: %s", provider.Type)
+	}
+
+	// The state carries the query of the Casdoor login page, which Casdoor's callback page uses to finish the login
+	innerQuery := fmt.Sprintf("?client_id=%s&response_type=code&redirect_uri=%s&scope=read&state=%s&application=%s&provider=%s&method=signup",
+		c.ClientId, url.QueryEscape(redirectUri), url.QueryEscape(c.ApplicationName), url.QueryEscape(c.ApplicationName), url.QueryEscape(provider.Name))
+	state := base64.StdEncoding.EncodeToString([]byte(innerQuery))
+	callbackUrl := fmt.Sprintf("%s/callback", c.Endpoint)
+
+	clientIdKey := "client_id"
+	if provider.Type == "WeChat" {
+		clientIdKey = "appid"
+	}
+
+	signinUrl := fmt.Sprintf("%s?%s=%s&redirect_uri=%s&scope=%s&response_type=code&state=%s",
+		info.endpoint, clientIdKey, url.QueryEscape(provider.ClientId), url.QueryEscape(callbackUrl), url.QueryEscape(info.scope), url.QueryEscape(state))
+
+	switch provider.Type {
+	case "WeChat":
+		signinUrl += "#wechat_redirect"
+	case "DingTalk":
+		signinUrl += "&prompt=consent"
+	}
+
+	return signinUrl, nil
+}
+
+// GetProviderSigninUrls returns the provider sign-in URLs of the application's OAuth providers that allow sign-in,
+// keyed by provider name. Providers whose URL cannot be built, e.g. because of an unsupported type, are skipped.
+func (c *Client) GetProviderSigninUrls(application *Application, redirectUri string) map[string]string {
+	signinUrls := map[string]string{}
+	for _, providerItem := range application.Providers {
+		if providerItem.Provider == nil || !providerItem.CanSignIn {
+			continue
+		}
+
+		signinUrl, err := c.GetProviderSigninUrl(providerItem.Provider, redirectUri)
+		if err != nil {
+			continue
+		}
+		signinUrls[providerItem.Provider.Name] = signinUrl
+	}
+	return signinUrls
+}
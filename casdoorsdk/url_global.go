@@ -29,3 +29,11 @@ func GetUserProfileUrl(userName string, accessToken string) string {
 func GetMyProfileUrl(accessToken string) string {
 	return globalClient.GetMyProfileUrl(accessToken)
 }
+
+func GetProviderSigninUrl(provider *Provider, redirectUri string) (string, error) {
+	return globalClient.GetProviderSigninUrl(provider, redirectUri)
+}
+
+func GetProviderSigninUrls(application *Application, redirectUri string) map[string]string {
+	return globalClient.GetProviderSigninUrls(application, redirectUri)
+}
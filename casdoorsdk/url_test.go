@@ -0,0 +1,62 @@
+// Copyright 2025 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"encoding/base64"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestGetProviderSigninUrl(t *testing.T) {
+	client := NewClient(TestCasdoorEndpoint, TestClientId, TestClientSecret, TestJwtPublicKey, TestCasdoorOrganization, TestCasdoorApplication)
+
+	provider := &Provider{Name: "provider_github", Category: "OAuth", Type: "GitHub", ClientId: "github-client-id"}
+	signinUrl, err := client.GetProviderSigninUrl(provider, "http://localhost:9000/callback")
+	if err != nil {
+		t.Fatalf("Failed to get provider signin url: %v", err)
+	}
+
+	u, err := url.Parse(signinUrl)
+	if err != nil {
+		t.Fatalf("Failed to parse provider signin url: %v", err)
+	}
+	if u.Host != "github.com" || u.Query().Get("client_id") != "github-client-id" || u.Query().Get("redirect_uri") != TestCasdoorEndpoint+"/callback" {
+		t.Fatalf("Unexpected provider signin url: %s", signinUrl)
+	}
+
+	innerQuery, err := base64.StdEncoding.DecodeString(u.Query().Get("state"))
+	if err != nil {
+		t.Fatalf("Failed to decode state: %v", err)
+	}
+	params, err := url.ParseQuery(strings.TrimPrefix(string(innerQuery), "?"))
+	if err != nil {
+		t.Fatalf("Failed to parse state: %v", err)
+	}
+	if params.Get("client_id") != TestClientId || params.Get("provider") != provider.Name || params.Get("redirect_uri") != "http://localhost:9000/callback" {
+		t.Fatalf("Unexpected state: %s", innerQuery)
+	}
+
+	application := &Application{Providers: []*ProviderItem{
+		{CanSignIn: true, Provider: provider},
+		{CanSignIn: true, Provider: &Provider{Name: "provider_email", Category: "Email", Type: "SMTP"}},
+		{CanSignIn: false, Provider: &Provider{Name: "provider_google", Category: "OAuth", Type: "Google"}},
+	}}
+	signinUrls := client.GetProviderSigninUrls(application, "http://localhost:9000/callback")
+	if len(signinUrls) != 1 || signinUrls[provider.Name] != signinUrl {
+		t.Fatalf("Unexpected provider signin urls: %v", signinUrls)
+	}
+}
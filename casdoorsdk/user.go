@@ -15,7 +15,6 @@
 package casdoorsdk
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
@@ -219,13 +218,38 @@ func (c *Client) GetGlobalUsers() ([]*User, error) {
 	}
 
 	var users []*User
-	err = json.Unmarshal(bytes, &users)
+	err = jsonCodec.Unmarshal(bytes, &users)
 	if err != nil {
 		return nil, err
 	}
 	return users, nil
 }
 
+func (c *Client) GetPaginationGlobalUsers(p int, pageSize int, queryMap map[string]string) ([]*User, int, error) {
+	queryMap["p"] = strconv.Itoa(p)
+	queryMap["pageSize"] = strconv.Itoa(pageSize)
+
+	url := c.GetUrl("get-global-users", queryMap)
+
+	response, err := c.DoGetResponse(url)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	dataBytes, err := jsonCodec.Marshal(response.Data)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var users []*User
+	err = jsonCodec.Unmarshal(dataBytes, &users)
+	if err != nil {
+		return nil, 0, errors.New("response data format is incorrect")
+	}
+
+	return users, int(response.Data2.(float64)), nil
+}
+
 func (c *Client) GetUsers() ([]*User, error) {
 	queryMap := map[string]string{
 		"owner": c.OrganizationName,
@@ -239,7 +263,7 @@ func (c *Client) GetUsers() ([]*User, error) {
 	}
 
 	var users []*User
-	err = json.Unmarshal(bytes, &users)
+	err = jsonCodec.Unmarshal(bytes, &users)
 	if err != nil {
 		return nil, err
 	}
@@ -261,7 +285,7 @@ func (c *Client) GetSortedUsers(sorter string, limit int) ([]*User, error) {
 	}
 
 	var users []*User
-	err = json.Unmarshal(bytes, &users)
+	err = jsonCodec.Unmarshal(bytes, &users)
 	if err != nil {
 		return nil, err
 	}
@@ -280,13 +304,13 @@ func (c *Client) GetPaginationUsers(p int, pageSize int, queryMap map[string]str
 		return nil, 0, err
 	}
 
-	dataBytes, err := json.Marshal(response.Data)
+	dataBytes, err := jsonCodec.Marshal(response.Data)
 	if err != nil {
 		return nil, 0, err
 	}
 
 	var users []*User
-	err = json.Unmarshal(dataBytes, &users)
+	err = jsonCodec.Unmarshal(dataBytes, &users)
 	if err != nil {
 		return nil, 0, errors.New("response data format is incorrect")
 	}
@@ -308,7 +332,7 @@ func (c *Client) GetUserCount(isOnline string) (int, error) {
 	}
 
 	var count int
-	err = json.Unmarshal(bytes, &count)
+	err = jsonCodec.Unmarshal(bytes, &count)
 	if err != nil {
 		return -1, err
 	}
@@ -328,7 +352,7 @@ func (c *Client) GetUser(name string) (*User, error) {
 	}
 
 	var user *User
-	err = json.Unmarshal(bytes, &user)
+	err = jsonCodec.Unmarshal(bytes, &user)
 	if err != nil {
 		return nil, err
 	}
@@ -349,7 +373,7 @@ func (c *Client) GetUserByEmail(email string) (*User, error) {
 	}
 
 	var user *User
-	err = json.Unmarshal(bytes, &user)
+	err = jsonCodec.Unmarshal(bytes, &user)
 	if err != nil {
 		return nil, err
 	}
@@ -370,7 +394,7 @@ func (c *Client) GetUserByPhone(phone string) (*User, error) {
 	}
 
 	var user *User
-	err = json.Unmarshal(bytes, &user)
+	err = jsonCodec.Unmarshal(bytes, &user)
 	if err != nil {
 		return nil, err
 	}
@@ -391,7 +415,7 @@ func (c *Client) GetUserByUserId(userId string) (*User, error) {
 	}
 
 	var user *User
-	err = json.Unmarshal(bytes, &user)
+	err = jsonCodec.Unmarshal(bytes, &user)
 	if err != nil {
 		return nil, err
 	}
@@ -407,7 +431,7 @@ func (c *Client) SetPassword(owner, name, oldPassword, newPassword string) (bool
 		"newPassword": newPassword,
 	}
 
-	bytes, err := json.Marshal(param)
+	bytes, err := jsonCodec.Marshal(param)
 	if err != nil {
 		return false, err
 	}
@@ -18,6 +18,10 @@ func GetGlobalUsers() ([]*User, error) {
 	return globalClient.GetGlobalUsers()
 }
 
+func GetPaginationGlobalUsers(p int, pageSize int, queryMap map[string]string) ([]*User, int, error) {
+	return globalClient.GetPaginationGlobalUsers(p, pageSize, queryMap)
+}
+
 func GetUsers() ([]*User, error) {
 	return globalClient.GetUsers()
 }
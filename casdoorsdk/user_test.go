@@ -89,3 +89,26 @@ func TestUser(t *testing.T) {
 		t.Fatalf("Failed to delete object, it's still retrievable")
 	}
 }
+
+func TestGetGlobalUsers(t *testing.T) {
+	server := getTestGlobalListServer(t, "get-global-users")
+	defer server.Close()
+
+	client := NewClient(server.URL, TestClientId, TestClientSecret, TestJwtPublicKey, TestCasdoorOrganization, TestCasdoorApplication)
+
+	users, err := client.GetGlobalUsers()
+	if err != nil {
+		t.Fatalf("Failed to get objects: %v", err)
+	}
+	if len(users) != 2 || users[0].Name != "a" {
+		t.Fatalf("Unexpected objects: %v", users)
+	}
+
+	users, count, err := client.GetPaginationGlobalUsers(2, 10, map[string]string{"field": "name", "value": "a"})
+	if err != nil {
+		t.Fatalf("Failed to get objects: %v", err)
+	}
+	if len(users) != 2 || count != 25 {
+		t.Fatalf("Expected 2 objects of 25, got %d of %d", len(users), count)
+	}
+}
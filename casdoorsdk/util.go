@@ -16,12 +16,9 @@ package casdoorsdk
 
 import (
 	"bytes"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
-	"mime/multipart"
-	"net/http"
 	"net/url"
 	"strings"
 	"time"
@@ -41,43 +38,6 @@ func (c *Client) GetId(name string) string {
 	return c.OrganizationName + "/" + name
 }
 
-func createFormFile(formData map[string][]byte) (string, io.Reader, error) {
-	// https://tonybai.com/2021/01/16/upload-and-download-file-using-multipart-form-over-http/
-
-	body := new(bytes.Buffer)
-	w := multipart.NewWriter(body)
-	defer w.Close()
-
-	for k, v := range formData {
-		pw, err := w.CreateFormFile(k, "file")
-		if err != nil {
-			panic(err)
-		}
-
-		_, err = pw.Write(v)
-		if err != nil {
-			panic(err)
-		}
-	}
-
-	return w.FormDataContentType(), body, nil
-}
-
-func createForm(formData map[string]string) (string, io.Reader, error) {
-	body := new(bytes.Buffer)
-	w := multipart.NewWriter(body)
-	for k, v := range formData {
-		if err := w.WriteField(k, v); err != nil {
-			return "", nil, err
-		}
-	}
-	if err := w.Close(); err != nil {
-		return "", nil, err
-	}
-
-	return w.FormDataContentType(), body, nil
-}
-
 func GetCurrentTime() string {
 	timestamp := time.Now().Unix()
 	tm := time.Unix(timestamp, 0)
@@ -92,13 +52,13 @@ func (c *Client) DoGetResponse(url string) (*Response, error) {
 	}
 
 	var response Response
-	err = json.Unmarshal(respBytes, &response)
+	err = jsonCodec.Unmarshal(respBytes, &response)
 	if err != nil {
 		return nil, err
 	}
 
 	if response.Status != "ok" {
-		return nil, fmt.Errorf(response.Msg)
+		return nil, errors.New(response.Msg)
 	}
 
 	return &response, nil
@@ -111,7 +71,7 @@ func (c *Client) DoGetBytes(url string) ([]byte, error) {
 		return nil, err
 	}
 
-	res, err := json.Marshal(response.Data)
+	res, err := jsonCodec.Marshal(response.Data)
 	if err != nil {
 		return nil, err
 	}
@@ -127,7 +87,7 @@ func (c *Client) DoGetBytesRaw(url string) ([]byte, error) {
 	}
 
 	var response Response
-	err = json.Unmarshal(respBytes, &response)
+	err = jsonCodec.Unmarshal(respBytes, &response)
 	if err == nil && response.Status == "error" {
 		return nil, errors.New(response.Msg)
 	}
@@ -149,7 +109,7 @@ func (c *Client) DoPost(action string, queryMap map[string]string, postBytes []b
 			}
 		} else {
 			var params map[string]string
-			err = json.Unmarshal(postBytes, &params)
+			err = jsonCodec.Unmarshal(postBytes, &params)
 			if err != nil {
 				return nil, err
 			}
@@ -170,77 +130,21 @@ func (c *Client) DoPost(action string, queryMap map[string]string, postBytes []b
 	}
 
 	var response Response
-	err = json.Unmarshal(respBytes, &response)
+	err = jsonCodec.Unmarshal(respBytes, &response)
 	if err != nil {
 		return nil, err
 	}
 
 	if response.Status != "ok" {
-		return nil, fmt.Errorf(response.Msg)
+		return nil, errors.New(response.Msg)
 	}
 
 	return &response, nil
 }
 
-// DoPostBytesRaw is a general function to post a request from url, body through HTTP Post method.
-func (c *Client) DoPostBytesRaw(url string, contentType string, body io.Reader) ([]byte, error) {
-	if contentType == "" {
-		contentType = "text/plain;charset=UTF-8"
-	}
-
-	var resp *http.Response
-
-	req, err := http.NewRequest("POST", url, body)
-	if err != nil {
-		return nil, err
-	}
-
-	req.SetBasicAuth(c.ClientId, c.ClientSecret)
-	req.Header.Set("Content-Type", contentType)
-
-	// Add custom headers
-	for key, value := range c.CustomHeaders {
-		req.Header.Set(key, value)
-	}
-
-	resp, err = client.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer func(Body io.ReadCloser) {
-		err := Body.Close()
-		if err != nil {
-			return
-		}
-	}(resp.Body)
-
-	respBytes, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-
-	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusForbidden {
-		return nil, fmt.Errorf("status code: %d, status: %s, body: %s", resp.StatusCode, resp.Status, string(respBytes))
-	}
-
-	return respBytes, nil
-}
-
 // doGetBytesRawWithoutCheck is a general function to get response from param url through HTTP Get method without checking response status
 func (c *Client) doGetBytesRawWithoutCheck(url string) ([]byte, error) {
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	req.SetBasicAuth(c.ClientId, c.ClientSecret)
-
-	// Add custom headers
-	for key, value := range c.CustomHeaders {
-		req.Header.Set(key, value)
-	}
-
-	resp, err := client.Do(req)
+	body, _, err := c.doGetStream(url)
 	if err != nil {
 		return nil, err
 	}
@@ -249,16 +153,7 @@ func (c *Client) doGetBytesRawWithoutCheck(url string) ([]byte, error) {
 		if err != nil {
 			return
 		}
-	}(resp.Body)
+	}(body)
 
-	respBytes, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-
-	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusForbidden {
-		return nil, fmt.Errorf("status code: %d, status: %s, body: %s", resp.StatusCode, resp.Status, string(respBytes))
-	}
-
-	return respBytes, nil
+	return io.ReadAll(body)
 }
@@ -14,7 +14,20 @@
 
 package casdoorsdk
 
-import "io"
+import (
+	"context"
+	"io"
+)
+
+// WithHeader returns a copy of the global client that additionally sends the given header on every request.
+func WithHeader(key string, value string) *Client {
+	return globalClient.WithHeader(key, value)
+}
+
+// WithContext returns a copy of the global client whose requests share ctx and its deadline.
+func WithContext(ctx context.Context) *Client {
+	return globalClient.WithContext(ctx)
+}
 
 func GetUrl(action string, queryMap map[string]string) string {
 	return globalClient.GetUrl(action, queryMap)
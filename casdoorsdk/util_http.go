@@ -0,0 +1,139 @@
+// Copyright 2021 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !tinygo
+
+package casdoorsdk
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+)
+
+func createFormFile(formData map[string][]byte) (string, io.Reader, error) {
+	// https://tonybai.com/2021/01/16/upload-and-download-file-using-multipart-form-over-http/
+
+	body := new(bytes.Buffer)
+	w := multipart.NewWriter(body)
+	defer w.Close()
+
+	for k, v := range formData {
+		pw, err := w.CreateFormFile(k, "file")
+		if err != nil {
+			panic(err)
+		}
+
+		_, err = pw.Write(v)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	return w.FormDataContentType(), body, nil
+}
+
+func createForm(formData map[string]string) (string, io.Reader, error) {
+	body := new(bytes.Buffer)
+	w := multipart.NewWriter(body)
+	for k, v := range formData {
+		if err := w.WriteField(k, v); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := w.Close(); err != nil {
+		return "", nil, err
+	}
+
+	return w.FormDataContentType(), body, nil
+}
+
+// DoPostBytesRaw is a general function to post a request from url, body through HTTP Post method.
+func (c *Client) DoPostBytesRaw(url string, contentType string, body io.Reader) ([]byte, error) {
+	if contentType == "" {
+		contentType = "text/plain;charset=UTF-8"
+	}
+
+	var resp *http.Response
+
+	req, err := http.NewRequestWithContext(c.getContext(), "POST", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.SetBasicAuth(c.ClientId, c.ClientSecret)
+	req.Header.Set("Content-Type", contentType)
+
+	c.setCustomHeaders(req)
+
+	resp, err = c.doHttpRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func(Body io.ReadCloser) {
+		err := Body.Close()
+		if err != nil {
+			return
+		}
+	}(resp.Body)
+
+	respBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusForbidden {
+		return nil, fmt.Errorf("status code: %d, status: %s, body: %s", resp.StatusCode, resp.Status, string(respBytes))
+	}
+
+	return respBytes, nil
+}
+
+// setCustomHeaders adds the client's custom headers to the request, overriding any existing headers with the same name.
+func (c *Client) setCustomHeaders(req *http.Request) {
+	for key, value := range c.CustomHeaders {
+		req.Header.Set(key, value)
+	}
+}
+
+// doGetStream sends a GET request to url and returns the response body unread with its content type,
+// the caller must close the body.
+func (c *Client) doGetStream(url string) (io.ReadCloser, string, error) {
+	req, err := http.NewRequestWithContext(c.getContext(), "GET", url, nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	req.SetBasicAuth(c.ClientId, c.ClientSecret)
+
+	c.setCustomHeaders(req)
+
+	resp, err := c.doHttpRequest(req)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusForbidden {
+		defer resp.Body.Close()
+		respBytes, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, "", err
+		}
+		return nil, "", fmt.Errorf("status code: %d, status: %s, body: %s", resp.StatusCode, resp.Status, string(respBytes))
+	}
+
+	return resp.Body, resp.Header.Get("Content-Type"), nil
+}
@@ -15,7 +15,6 @@
 package casdoorsdk
 
 import (
-	"encoding/json"
 	"fmt"
 	"strings"
 )
@@ -35,7 +34,7 @@ func (c *Client) modifyOrganization(action string, organization *Organization, c
 		queryMap["columns"] = strings.Join(columns, ",")
 	}
 
-	postBytes, err := json.Marshal(organization)
+	postBytes, err := jsonCodec.Marshal(organization)
 	if err != nil {
 		return nil, false, err
 	}
@@ -63,7 +62,7 @@ func (c *Client) modifyApplication(action string, application *Application, colu
 		queryMap["columns"] = strings.Join(columns, ",")
 	}
 
-	postBytes, err := json.Marshal(application)
+	postBytes, err := jsonCodec.Marshal(application)
 	if err != nil {
 		return nil, false, err
 	}
@@ -88,7 +87,7 @@ func (c *Client) modifyProvider(action string, provider *Provider, columns []str
 	}
 
 	provider.Owner = c.OrganizationName
-	postBytes, err := json.Marshal(provider)
+	postBytes, err := jsonCodec.Marshal(provider)
 	if err != nil {
 		return nil, false, err
 	}
@@ -113,7 +112,7 @@ func (c *Client) modifySession(action string, session *Session, columns []string
 	}
 
 	session.Owner = c.OrganizationName
-	postBytes, err := json.Marshal(session)
+	postBytes, err := jsonCodec.Marshal(session)
 	if err != nil {
 		return nil, false, err
 	}
@@ -144,7 +143,7 @@ func (c *Client) modifyUserById(action string, id string, user *User, columns []
 	if user.Owner == "" {
 		user.Owner = c.OrganizationName
 	}
-	postBytes, err := json.Marshal(user)
+	postBytes, err := jsonCodec.Marshal(user)
 	if err != nil {
 		return nil, false, err
 	}
@@ -171,7 +170,7 @@ func (c *Client) modifyUserByUserId(action string, owner string, userId string,
 		queryMap["columns"] = strings.Join(columns, ",")
 	}
 
-	postBytes, err := json.Marshal(user)
+	postBytes, err := jsonCodec.Marshal(user)
 	if err != nil {
 		return nil, false, err
 	}
@@ -200,7 +199,7 @@ func (c *Client) modifyPermission(action string, permission *Permission, columns
 	}
 
 	permission.Owner = c.OrganizationName
-	postBytes, err := json.Marshal(permission)
+	postBytes, err := jsonCodec.Marshal(permission)
 	if err != nil {
 		return nil, false, err
 	}
@@ -225,7 +224,7 @@ func (c *Client) modifyRole(action string, role *Role, columns []string) (*Respo
 	}
 
 	role.Owner = c.OrganizationName
-	postBytes, err := json.Marshal(role)
+	postBytes, err := jsonCodec.Marshal(role)
 	if err != nil {
 		return nil, false, err
 	}
@@ -252,7 +251,7 @@ func (c *Client) modifyCert(action string, cert *Cert, columns []string) (*Respo
 	if cert.Owner == "" {
 		cert.Owner = c.OrganizationName
 	}
-	postBytes, err := json.Marshal(cert)
+	postBytes, err := jsonCodec.Marshal(cert)
 	if err != nil {
 		return nil, false, err
 	}
@@ -276,7 +275,7 @@ func (c *Client) modifyEnforcer(action string, enforcer *Enforcer, columns []str
 	}
 
 	enforcer.Owner = c.OrganizationName
-	postBytes, err := json.Marshal(enforcer)
+	postBytes, err := jsonCodec.Marshal(enforcer)
 	if err != nil {
 		return nil, false, err
 	}
@@ -303,9 +302,9 @@ func (c *Client) modifyPolicy(action string, enforcer *Enforcer, policies []*Cas
 	var postBytes []byte
 	var err error
 	if action == "update-policy" {
-		postBytes, err = json.Marshal(policies)
+		postBytes, err = jsonCodec.Marshal(policies)
 	} else {
-		postBytes, err = json.Marshal(policies[0])
+		postBytes, err = jsonCodec.Marshal(policies[0])
 	}
 
 	if err != nil {
@@ -332,7 +331,7 @@ func (c *Client) modifyGroup(action string, group *Group, columns []string) (*Re
 	}
 
 	group.Owner = c.OrganizationName
-	postBytes, err := json.Marshal(group)
+	postBytes, err := jsonCodec.Marshal(group)
 	if err != nil {
 		return nil, false, err
 	}
@@ -357,7 +356,7 @@ func (c *Client) modifyAdapter(action string, adapter *Adapter, columns []string
 	}
 
 	adapter.Owner = c.OrganizationName
-	postBytes, err := json.Marshal(adapter)
+	postBytes, err := jsonCodec.Marshal(adapter)
 	if err != nil {
 		return nil, false, err
 	}
@@ -382,7 +381,7 @@ func (c *Client) modifyModel(action string, model *Model, columns []string) (*Re
 	}
 
 	model.Owner = c.OrganizationName
-	postBytes, err := json.Marshal(model)
+	postBytes, err := jsonCodec.Marshal(model)
 	if err != nil {
 		return nil, false, err
 	}
@@ -407,7 +406,7 @@ func (c *Client) modifyProduct(action string, product *Product, columns []string
 	}
 
 	product.Owner = c.OrganizationName
-	postBytes, err := json.Marshal(product)
+	postBytes, err := jsonCodec.Marshal(product)
 	if err != nil {
 		return nil, false, err
 	}
@@ -432,7 +431,7 @@ func (c *Client) modifyPayment(action string, payment *Payment, columns []string
 	}
 
 	payment.Owner = c.OrganizationName
-	postBytes, err := json.Marshal(payment)
+	postBytes, err := jsonCodec.Marshal(payment)
 	if err != nil {
 		return nil, false, err
 	}
@@ -457,7 +456,7 @@ func (c *Client) modifyPlan(action string, plan *Plan, columns []string) (*Respo
 	}
 
 	plan.Owner = c.OrganizationName
-	postBytes, err := json.Marshal(plan)
+	postBytes, err := jsonCodec.Marshal(plan)
 	if err != nil {
 		return nil, false, err
 	}
@@ -482,7 +481,7 @@ func (c *Client) modifyPricing(action string, pricing *Pricing, columns []string
 	}
 
 	pricing.Owner = c.OrganizationName
-	postBytes, err := json.Marshal(pricing)
+	postBytes, err := jsonCodec.Marshal(pricing)
 	if err != nil {
 		return nil, false, err
 	}
@@ -507,7 +506,7 @@ func (c *Client) modifySubscription(action string, subscription *Subscription, c
 	}
 
 	subscription.Owner = c.OrganizationName
-	postBytes, err := json.Marshal(subscription)
+	postBytes, err := jsonCodec.Marshal(subscription)
 	if err != nil {
 		return nil, false, err
 	}
@@ -532,7 +531,7 @@ func (c *Client) modifySyncer(action string, syncer *Syncer, columns []string) (
 	}
 
 	syncer.Owner = c.OrganizationName
-	postBytes, err := json.Marshal(syncer)
+	postBytes, err := jsonCodec.Marshal(syncer)
 	if err != nil {
 		return nil, false, err
 	}
@@ -568,7 +567,7 @@ func (c *Client) modifyTransactionWithDryRun(action string, transaction *Transac
 	}
 
 	transaction.Owner = c.OrganizationName
-	postBytes, err := json.Marshal(transaction)
+	postBytes, err := jsonCodec.Marshal(transaction)
 	if err != nil {
 		return nil, false, err
 	}
@@ -593,7 +592,7 @@ func (c *Client) modifyWebhook(action string, webhook *Webhook, columns []string
 	}
 
 	webhook.Owner = c.OrganizationName
-	postBytes, err := json.Marshal(webhook)
+	postBytes, err := jsonCodec.Marshal(webhook)
 	if err != nil {
 		return nil, false, err
 	}
@@ -619,7 +618,7 @@ func (c *Client) modifyToken(action string, token *Token, columns []string) (*Re
 		queryMap["columns"] = strings.Join(columns, ",")
 	}
 
-	postBytes, err := json.Marshal(token)
+	postBytes, err := jsonCodec.Marshal(token)
 	if err != nil {
 		return nil, false, err
 	}
@@ -647,7 +646,7 @@ func (c *Client) modifyLdap(action string, ldap *Ldap, columns []string) (*Respo
 		queryMap["columns"] = strings.Join(columns, ",")
 	}
 
-	postBytes, err := json.Marshal(ldap)
+	postBytes, err := jsonCodec.Marshal(ldap)
 	if err != nil {
 		return nil, false, err
 	}
@@ -674,7 +673,7 @@ func (c *Client) modifyInvitation(action string, invitation *Invitation, columns
 	if invitation.Owner == "" {
 		invitation.Owner = c.OrganizationName
 	}
-	postBytes, err := json.Marshal(invitation)
+	postBytes, err := jsonCodec.Marshal(invitation)
 	if err != nil {
 		return nil, false, err
 	}
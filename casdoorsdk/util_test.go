@@ -0,0 +1,83 @@
+// Copyright 2025 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestWithHeader(t *testing.T) {
+	var headers []http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers = append(headers, r.Header.Clone())
+		w.Write([]byte(`{"status":"ok","msg":"","data":"Affected"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, TestClientId, TestClientSecret, TestJwtPublicKey, TestCasdoorOrganization, TestCasdoorApplication)
+	client.CustomHeaders["X-Tenant-Id"] = "tenant-1"
+
+	_, err := client.WithHeader("X-Request-Id", "request-1").DoGetResponse(client.GetUrl("get-users", nil))
+	if err != nil {
+		t.Fatalf("Failed to get response: %v", err)
+	}
+	_, err = client.WithHeader("X-Request-Id", "request-2").DoPost("upload-resource", nil, []byte("file"), true, true)
+	if err != nil {
+		t.Fatalf("Failed to upload: %v", err)
+	}
+	_, err = client.DoGetResponse(client.GetUrl("get-users", nil))
+	if err != nil {
+		t.Fatalf("Failed to get response: %v", err)
+	}
+
+	expected := []string{"request-1", "request-2", ""}
+	if len(headers) != len(expected) {
+		t.Fatalf("Expected %d requests, got %d", len(expected), len(headers))
+	}
+	for i, header := range headers {
+		if header.Get("X-Tenant-Id") != "tenant-1" {
+			t.Fatalf("Request %d is missing the client-level header", i)
+		}
+		if header.Get("X-Request-Id") != expected[i] {
+			t.Fatalf("Request %d has X-Request-Id %q, expected %q", i, header.Get("X-Request-Id"), expected[i])
+		}
+	}
+
+	if _, ok := client.CustomHeaders["X-Request-Id"]; ok {
+		t.Fatalf("WithHeader modified the original client")
+	}
+}
+
+// getTestGlobalListServer returns a server for the get-global-* action that responds with two objects named
+// "a" and "b", and with a total of 25 when the request is paginated as page 2 of 10 filtered by name.
+func getTestGlobalListServer(t *testing.T, action string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/"+action {
+			t.Errorf("Unexpected action: %s", r.URL.Path)
+		}
+
+		query := r.URL.Query()
+		if !query.Has("p") {
+			w.Write([]byte(`{"status":"ok","msg":"","data":[{"name":"a"},{"name":"b"}]}`))
+			return
+		}
+		if query.Get("p") != "2" || query.Get("pageSize") != "10" || query.Get("field") != "name" || query.Get("value") != "a" {
+			t.Errorf("Unexpected pagination query: %s", r.URL.RawQuery)
+		}
+		w.Write([]byte(`{"status":"ok","msg":"","data":[{"name":"a"},{"name":"b"}],"data2":25}`))
+	}))
+}
@@ -0,0 +1,43 @@
+// Copyright 2025 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build tinygo
+
+package casdoorsdk
+
+import (
+	"errors"
+	"io"
+)
+
+// errNoHttp is returned by API calls in TinyGo builds, which leave out net/http, mime/multipart
+// and golang.org/x/oauth2. Token parsing, claims and URL builders work without them.
+var errNoHttp = errors.New("casdoorsdk: the Casdoor API is not available in TinyGo builds")
+
+func createFormFile(formData map[string][]byte) (string, io.Reader, error) {
+	return "", nil, errNoHttp
+}
+
+func createForm(formData map[string]string) (string, io.Reader, error) {
+	return "", nil, errNoHttp
+}
+
+// DoPostBytesRaw always fails in TinyGo builds.
+func (c *Client) DoPostBytesRaw(url string, contentType string, body io.Reader) ([]byte, error) {
+	return nil, errNoHttp
+}
+
+func (c *Client) doGetStream(url string) (io.ReadCloser, string, error) {
+	return nil, "", errNoHttp
+}
@@ -15,7 +15,6 @@
 package casdoorsdk
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
@@ -59,7 +58,7 @@ func (c *Client) GetWebhooks() ([]*Webhook, error) {
 	}
 
 	var webhooks []*Webhook
-	err = json.Unmarshal(bytes, &webhooks)
+	err = jsonCodec.Unmarshal(bytes, &webhooks)
 	if err != nil {
 		return nil, err
 	}
@@ -78,13 +77,13 @@ func (c *Client) GetPaginationWebhooks(p int, pageSize int, queryMap map[string]
 		return nil, 0, err
 	}
 
-	dataBytes, err := json.Marshal(response.Data)
+	dataBytes, err := jsonCodec.Marshal(response.Data)
 	if err != nil {
 		return nil, 0, err
 	}
 
 	var webhooks []*Webhook
-	err = json.Unmarshal(dataBytes, &webhooks)
+	err = jsonCodec.Unmarshal(dataBytes, &webhooks)
 	if err != nil {
 		return nil, 0, errors.New("response data format is incorrect")
 	}
@@ -105,7 +104,7 @@ func (c *Client) GetWebhook(name string) (*Webhook, error) {
 	}
 
 	var webhook *Webhook
-	err = json.Unmarshal(bytes, &webhook)
+	err = jsonCodec.Unmarshal(bytes, &webhook)
 	if err != nil {
 		return nil, err
 	}
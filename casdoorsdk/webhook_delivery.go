@@ -0,0 +1,368 @@
+// Copyright 2025 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !tinygo
+
+package casdoorsdk
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Headers of the requests sent by WebhookQueue.
+const (
+	// WebhookSignatureHeader holds the signature of the request body, as returned by GetWebhookSignature.
+	WebhookSignatureHeader = "X-Casdoor-Signature"
+	// WebhookDeliveryHeader holds the ID of the delivery, which stays the same across retries so receivers can deduplicate.
+	WebhookDeliveryHeader = "X-Casdoor-Delivery"
+)
+
+// GetWebhookSignature returns the signature of a webhook body, "sha256=" followed by the hex encoded HMAC-SHA256 of the body keyed with secret.
+func GetWebhookSignature(body []byte, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyWebhookSignature returns true if signature, e.g. the value of the WebhookSignatureHeader header, is the signature of body.
+func VerifyWebhookSignature(body []byte, signature string, secret string) bool {
+	return hmac.Equal([]byte(signature), []byte(GetWebhookSignature(body, secret)))
+}
+
+// WebhookDelivery is an event waiting to be delivered, or given up on, by a WebhookQueue.
+type WebhookDelivery struct {
+	Id              string            `json:"id"`
+	Url             string            `json:"url"`
+	Headers         map[string]string `json:"headers"`
+	Body            []byte            `json:"body"`
+	CreatedTime     time.Time         `json:"createdTime"`
+	Attempts        int               `json:"attempts"`
+	NextAttemptTime time.Time         `json:"nextAttemptTime"`
+	LastError       string            `json:"lastError"`
+}
+
+// WebhookStore persists the deliveries of a WebhookQueue, so that pending events survive a restart.
+// Implementations must be safe for concurrent use.
+type WebhookStore interface {
+	// SaveDelivery inserts or updates a pending delivery.
+	SaveDelivery(delivery *WebhookDelivery) error
+	// DeleteDelivery removes a pending delivery, after it was delivered or dead-lettered.
+	DeleteDelivery(id string) error
+	// GetDeliveries returns all pending deliveries.
+	GetDeliveries() ([]*WebhookDelivery, error)
+	// SaveDeadLetter stores a delivery that failed MaxAttempts times.
+	SaveDeadLetter(delivery *WebhookDelivery) error
+	// GetDeadLetters returns the dead-lettered deliveries.
+	GetDeadLetters() ([]*WebhookDelivery, error)
+}
+
+// memoryWebhookStore is the default WebhookStore, which keeps deliveries in memory only.
+type memoryWebhookStore struct {
+	mu          sync.Mutex
+	deliveries  map[string]*WebhookDelivery
+	deadLetters []*WebhookDelivery
+}
+
+func newMemoryWebhookStore() *memoryWebhookStore {
+	return &memoryWebhookStore{
+		deliveries: map[string]*WebhookDelivery{},
+	}
+}
+
+func (s *memoryWebhookStore) SaveDelivery(delivery *WebhookDelivery) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	copied := *delivery
+	s.deliveries[delivery.Id] = &copied
+	return nil
+}
+
+func (s *memoryWebhookStore) DeleteDelivery(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.deliveries, id)
+	return nil
+}
+
+func (s *memoryWebhookStore) GetDeliveries() ([]*WebhookDelivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	deliveries := []*WebhookDelivery{}
+	for _, delivery := range s.deliveries {
+		copied := *delivery
+		deliveries = append(deliveries, &copied)
+	}
+	return deliveries, nil
+}
+
+func (s *memoryWebhookStore) SaveDeadLetter(delivery *WebhookDelivery) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	copied := *delivery
+	s.deadLetters = append(s.deadLetters, &copied)
+	return nil
+}
+
+func (s *memoryWebhookStore) GetDeadLetters() ([]*WebhookDelivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	deadLetters := []*WebhookDelivery{}
+	for _, delivery := range s.deadLetters {
+		copied := *delivery
+		deadLetters = append(deadLetters, &copied)
+	}
+	return deadLetters, nil
+}
+
+// WebhookQueueOptions configures a WebhookQueue, zero values are replaced by the defaults.
+type WebhookQueueOptions struct {
+	// Secret signs the request bodies, no signature header is sent if it is empty.
+	Secret string
+	// MaxAttempts is the number of attempts before a delivery is dead-lettered, defaults to 8.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry, doubled on every further retry, defaults to 1 second.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between retries, defaults to 10 minutes.
+	MaxBackoff time.Duration
+	// PollInterval is how often Run checks for deliveries that are due, defaults to 1 second.
+	PollInterval time.Duration
+	// Store persists the deliveries, defaults to an in-memory store.
+	Store WebhookStore
+	// HttpClient sends the requests, defaults to the client set with SetHttpClient.
+	HttpClient HttpClient
+}
+
+// WebhookQueue relays events to webhook receivers, retrying failed deliveries with exponential backoff
+// and dead-lettering them after MaxAttempts. Add events with Enqueue and deliver them with Run.
+type WebhookQueue struct {
+	options WebhookQueueOptions
+
+	mu         sync.Mutex
+	deliveries map[string]*WebhookDelivery
+	wake       chan struct{}
+}
+
+// NewWebhookQueue creates a queue and loads the pending deliveries from its store.
+func NewWebhookQueue(options WebhookQueueOptions) (*WebhookQueue, error) {
+	if options.MaxAttempts <= 0 {
+		options.MaxAttempts = 8
+	}
+	if options.InitialBackoff <= 0 {
+		options.InitialBackoff = time.Second
+	}
+	if options.MaxBackoff <= 0 {
+		options.MaxBackoff = 10 * time.Minute
+	}
+	if options.PollInterval <= 0 {
+		options.PollInterval = time.Second
+	}
+	if options.Store == nil {
+		options.Store = newMemoryWebhookStore()
+	}
+
+	deliveries, err := options.Store.GetDeliveries()
+	if err != nil {
+		return nil, err
+	}
+
+	q := &WebhookQueue{
+		options:    options,
+		deliveries: map[string]*WebhookDelivery{},
+		wake:       make(chan struct{}, 1),
+	}
+	for _, delivery := range deliveries {
+		q.deliveries[delivery.Id] = delivery
+	}
+	return q, nil
+}
+
+// Enqueue adds an event for delivery to url by a POST request with the body and headers.
+// The Content-Type header defaults to application/json. The delivery is persisted before Enqueue returns,
+// the returned delivery is a copy that is not updated by later attempts.
+func (q *WebhookQueue) Enqueue(url string, body []byte, headers map[string]string) (*WebhookDelivery, error) {
+	id, err := getRandomHex(16)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	delivery := &WebhookDelivery{
+		Id:              id,
+		Url:             url,
+		Headers:         headers,
+		Body:            body,
+		CreatedTime:     now,
+		NextAttemptTime: now,
+	}
+	err = q.options.Store.SaveDelivery(delivery)
+	if err != nil {
+		return nil, err
+	}
+
+	q.mu.Lock()
+	q.deliveries[id] = delivery
+	q.mu.Unlock()
+
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+	copied := *delivery
+	return &copied, nil
+}
+
+// Pending returns the number of deliveries that are waiting to be delivered.
+func (q *WebhookQueue) Pending() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.deliveries)
+}
+
+// DeadLetters returns the deliveries that failed MaxAttempts times.
+func (q *WebhookQueue) DeadLetters() ([]*WebhookDelivery, error) {
+	return q.options.Store.GetDeadLetters()
+}
+
+// Run delivers the due events until ctx is done, and returns the error of ctx.
+// Errors of the store are retried on the next poll, as for failed deliveries.
+func (q *WebhookQueue) Run(ctx context.Context) error {
+	ticker := time.NewTicker(q.options.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		q.DeliverDue(ctx)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		case <-q.wake:
+		}
+	}
+}
+
+// DeliverDue attempts all deliveries whose next attempt is due, oldest first, and returns the number of successful deliveries.
+// It must not be called concurrently with itself or Run.
+func (q *WebhookQueue) DeliverDue(ctx context.Context) int {
+	now := time.Now()
+	due := []*WebhookDelivery{}
+	q.mu.Lock()
+	for _, delivery := range q.deliveries {
+		if !delivery.NextAttemptTime.After(now) {
+			due = append(due, delivery)
+		}
+	}
+	q.mu.Unlock()
+	sort.Slice(due, func(i, j int) bool {
+		return due[i].CreatedTime.Before(due[j].CreatedTime)
+	})
+
+	delivered := 0
+	for _, delivery := range due {
+		if ctx.Err() != nil {
+			break
+		}
+
+		err := q.send(ctx, delivery)
+		if err == nil {
+			if q.options.Store.DeleteDelivery(delivery.Id) == nil {
+				q.remove(delivery.Id)
+			}
+			delivered++
+			continue
+		}
+
+		// A delivery interrupted by shutdown was not rejected by the receiver, it is retried as is
+		if ctx.Err() != nil {
+			break
+		}
+
+		delivery.Attempts++
+		delivery.LastError = err.Error()
+		if delivery.Attempts >= q.options.MaxAttempts {
+			if q.options.Store.SaveDeadLetter(delivery) == nil && q.options.Store.DeleteDelivery(delivery.Id) == nil {
+				q.remove(delivery.Id)
+			}
+			continue
+		}
+
+		delivery.NextAttemptTime = time.Now().Add(q.getBackoff(delivery.Attempts))
+		err = q.options.Store.SaveDelivery(delivery)
+		if err != nil {
+			// The attempt is still counted in memory and saved with the next one
+			delivery.LastError = fmt.Sprintf("%s, failed to save the delivery: %v", delivery.LastError, err)
+		}
+	}
+	return delivered
+}
+
+func (q *WebhookQueue) remove(id string) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	delete(q.deliveries, id)
+}
+
+// getBackoff returns the delay after the given number of failed attempts.
+func (q *WebhookQueue) getBackoff(attempts int) time.Duration {
+	backoff := q.options.InitialBackoff
+	for i := 1; i < attempts && backoff < q.options.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > q.options.MaxBackoff {
+		backoff = q.options.MaxBackoff
+	}
+	return backoff
+}
+
+func (q *WebhookQueue) send(ctx context.Context, delivery *WebhookDelivery) error {
+	req, err := http.NewRequestWithContext(ctx, "POST", delivery.Url, bytes.NewReader(delivery.Body))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range delivery.Headers {
+		req.Header.Set(key, value)
+	}
+	req.Header.Set(WebhookDeliveryHeader, delivery.Id)
+	if q.options.Secret != "" {
+		req.Header.Set(WebhookSignatureHeader, GetWebhookSignature(delivery.Body, q.options.Secret))
+	}
+
+	httpClient := q.options.HttpClient
+	if httpClient == nil {
+		httpClient = client
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("status code: %d, status: %s", resp.StatusCode, resp.Status)
+	}
+	return nil
+}
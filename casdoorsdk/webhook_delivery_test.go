@@ -0,0 +1,192 @@
+// Copyright 2025 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casdoorsdk
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWebhookQueue(t *testing.T) {
+	requests := 0
+	deliveryIds := map[string]bool{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if !VerifyWebhookSignature(body, r.Header.Get(WebhookSignatureHeader), "secret") {
+			t.Errorf("Invalid signature: %s", r.Header.Get(WebhookSignatureHeader))
+		}
+		deliveryIds[r.Header.Get(WebhookDeliveryHeader)] = true
+
+		requests++
+		if r.URL.Path == "/dead" || requests < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	store := newMemoryWebhookStore()
+	queue, err := NewWebhookQueue(WebhookQueueOptions{
+		Secret:         "secret",
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		PollInterval:   time.Millisecond,
+		Store:          store,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create queue: %v", err)
+	}
+
+	_, err = queue.Enqueue(server.URL+"/events", []byte(`{"action":"signup"}`), nil)
+	if err != nil {
+		t.Fatalf("Failed to enqueue: %v", err)
+	}
+
+	// A new queue on the same store picks up the pending delivery
+	queue, err = NewWebhookQueue(WebhookQueueOptions{
+		Secret:         "secret",
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		PollInterval:   time.Millisecond,
+		Store:          store,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create queue: %v", err)
+	}
+	if queue.Pending() != 1 {
+		t.Fatalf("Expected the pending delivery to be loaded from the store")
+	}
+
+	_, err = queue.Enqueue(server.URL+"/dead", []byte(`{"action":"login"}`), nil)
+	if err != nil {
+		t.Fatalf("Failed to enqueue: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	go queue.Run(ctx)
+	for queue.Pending() != 0 && ctx.Err() == nil {
+		time.Sleep(time.Millisecond)
+	}
+	cancel()
+
+	if queue.Pending() != 0 {
+		t.Fatalf("Deliveries are still pending after %d requests", requests)
+	}
+	if len(deliveryIds) != 2 {
+		t.Fatalf("Retries should keep the delivery ID, got %v", deliveryIds)
+	}
+
+	deadLetters, err := queue.DeadLetters()
+	if err != nil {
+		t.Fatalf("Failed to get dead letters: %v", err)
+	}
+	if len(deadLetters) != 1 || deadLetters[0].Attempts != 3 || string(deadLetters[0].Body) != `{"action":"login"}` {
+		t.Fatalf("Unexpected dead letters: %+v", deadLetters)
+	}
+}
+
+func TestWebhookQueueShutdown(t *testing.T) {
+	received := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The body must be read for the server to notice that the client went away
+		io.ReadAll(r.Body)
+		close(received)
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	queue, err := NewWebhookQueue(WebhookQueueOptions{MaxAttempts: 1})
+	if err != nil {
+		t.Fatalf("Failed to create queue: %v", err)
+	}
+	delivery, err := queue.Enqueue(server.URL, []byte(`{}`), nil)
+	if err != nil {
+		t.Fatalf("Failed to enqueue: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-received
+		cancel()
+	}()
+	queue.DeliverDue(ctx)
+
+	deadLetters, err := queue.DeadLetters()
+	if err != nil {
+		t.Fatalf("Failed to get dead letters: %v", err)
+	}
+	attempts := queue.deliveries[delivery.Id].Attempts
+	if queue.Pending() != 1 || len(deadLetters) != 0 || attempts != 0 {
+		t.Fatalf("An interrupted delivery should stay pending without counting an attempt, got %d attempts and %d dead letters", attempts, len(deadLetters))
+	}
+}
+
+type failingWebhookStore struct {
+	*memoryWebhookStore
+	fail bool
+}
+
+func (s *failingWebhookStore) SaveDelivery(delivery *WebhookDelivery) error {
+	if s.fail {
+		return errors.New("database is down")
+	}
+	return s.memoryWebhookStore.SaveDelivery(delivery)
+}
+
+func TestWebhookQueueStoreError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	store := &failingWebhookStore{memoryWebhookStore: newMemoryWebhookStore()}
+	queue, err := NewWebhookQueue(WebhookQueueOptions{MaxAttempts: 3, InitialBackoff: time.Millisecond, Store: store})
+	if err != nil {
+		t.Fatalf("Failed to create queue: %v", err)
+	}
+	delivery, err := queue.Enqueue(server.URL, []byte(`{}`), nil)
+	if err != nil {
+		t.Fatalf("Failed to enqueue: %v", err)
+	}
+
+	store.fail = true
+	queue.DeliverDue(context.Background())
+	if delivery.Attempts != 0 {
+		t.Fatalf("The delivery returned by Enqueue should not be updated by attempts")
+	}
+	pending := queue.deliveries[delivery.Id]
+	if pending.Attempts != 1 || !strings.HasSuffix(pending.LastError, ", failed to save the delivery: database is down") {
+		t.Fatalf("Expected the attempt and the store error to be kept, got %d attempts and error %q", pending.Attempts, pending.LastError)
+	}
+
+	// The next attempt saves the count of both
+	store.fail = false
+	time.Sleep(2 * time.Millisecond)
+	queue.DeliverDue(context.Background())
+	deliveries, err := store.GetDeliveries()
+	if err != nil {
+		t.Fatalf("Failed to get deliveries: %v", err)
+	}
+	if len(deliveries) != 1 || deliveries[0].Attempts != 2 {
+		t.Fatalf("Expected the attempts to be saved on the next poll, got: %+v", deliveries)
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright 2025 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/casdoor/casdoor-go-sdk/casdoorsdk"
+)
+
+// Context is a named connection profile, holding the SDK configuration of one Casdoor instance and application.
+type Context struct {
+	Endpoint         string `json:"endpoint"`
+	ClientId         string `json:"clientId"`
+	ClientSecret     string `json:"clientSecret"`
+	Certificate      string `json:"certificate"`
+	OrganizationName string `json:"organizationName"`
+	ApplicationName  string `json:"applicationName"`
+}
+
+// Config is the content of the configuration file.
+type Config struct {
+	CurrentContext string              `json:"currentContext"`
+	Contexts       map[string]*Context `json:"contexts"`
+}
+
+// getDefaultConfigPath returns $CASDOORCTL_CONFIG, or casdoorctl/config.json in the user's configuration directory.
+func getDefaultConfigPath() string {
+	if path := os.Getenv("CASDOORCTL_CONFIG"); path != "" {
+		return path
+	}
+
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "casdoorctl.json"
+	}
+	return filepath.Join(dir, "casdoorctl", "config.json")
+}
+
+// loadConfig reads the configuration file, a missing file is an empty configuration.
+func loadConfig(path string) (*Config, error) {
+	config := &Config{Contexts: map[string]*Context{}}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return config, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(data, config)
+	if err != nil {
+		return nil, fmt.Errorf("invalid config file %s: %v", path, err)
+	}
+	if config.Contexts == nil {
+		config.Contexts = map[string]*Context{}
+	}
+	return config, nil
+}
+
+// saveConfig writes the configuration file, readable only by its owner since it holds client secrets.
+func saveConfig(path string, config *Config) error {
+	data, err := json.MarshalIndent(config, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(path), 0o700)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o600)
+}
+
+// getContextNames returns the names of the contexts in alphabetical order.
+func (c *Config) getContextNames() []string {
+	names := []string{}
+	for name := range c.Contexts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// getClient returns a client for the named context, or for the current context if name is empty.
+func (c *Config) getClient(name string) (*casdoorsdk.Client, error) {
+	if name == "" {
+		name = c.CurrentContext
+	}
+	if name == "" {
+		return nil, errors.New("no context selected, create one with \"casdoorctl context set\" or pass --context")
+	}
+
+	context, ok := c.Contexts[name]
+	if !ok {
+		return nil, fmt.Errorf("context %q not found", name)
+	}
+
+	return casdoorsdk.NewClientWithConf(&casdoorsdk.AuthConfig{
+		Endpoint:         context.Endpoint,
+		ClientId:         context.ClientId,
+		ClientSecret:     context.ClientSecret,
+		Certificate:      context.Certificate,
+		OrganizationName: context.OrganizationName,
+		ApplicationName:  context.ApplicationName,
+	}), nil
+}
@@ -0,0 +1,271 @@
+// Copyright 2025 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command casdoorctl manages the users, roles, permissions, applications and tokens of a Casdoor organization,
+// and checks permissions, using the Casdoor Go SDK.
+//
+// Usage:
+//
+//	casdoorctl [--config FILE] [--context NAME] [--output table|json|yaml] COMMAND [ARGS]
+//
+// Run "casdoorctl help" for the list of commands.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/casdoor/casdoor-go-sdk/casdoorsdk"
+)
+
+const usage = `Usage: casdoorctl [--config FILE] [--context NAME] [--output table|json|yaml] COMMAND [ARGS]
+
+Commands:
+  users|roles|permissions|applications|tokens list
+  users|roles|permissions|applications|tokens get NAME
+  users|roles|permissions|applications|tokens delete NAME
+  enforce [--permission ID] [--model ID] [--resource ID] [--enforcer ID] [--owner NAME] SUBJECT OBJECT ACTION...
+  context list
+  context use NAME
+  context set NAME [--endpoint URL] [--client-id ID] [--client-secret SECRET] [--certificate-file FILE]
+                   [--organization NAME] [--application NAME]
+  context delete NAME
+
+Contexts are stored in $CASDOORCTL_CONFIG, or casdoorctl/config.json in the user's configuration directory.
+`
+
+// command holds the global options of an invocation.
+type command struct {
+	config      *Config
+	configPath  string
+	contextName string
+	output      string
+	stdout      io.Writer
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// run executes the command line args and returns the exit code.
+func run(args []string, stdout io.Writer, stderr io.Writer) int {
+	flags := flag.NewFlagSet("casdoorctl", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprint(stderr, usage)
+	}
+
+	c := &command{stdout: stdout}
+	flags.StringVar(&c.configPath, "config", getDefaultConfigPath(), "path of the configuration file")
+	flags.StringVar(&c.contextName, "context", "", "context to use instead of the current one")
+	flags.StringVar(&c.output, "output", OutputTable, "output format")
+	flags.StringVar(&c.output, "o", OutputTable, "output format")
+	err := flags.Parse(args)
+	if errors.Is(err, flag.ErrHelp) {
+		return 0
+	}
+	if err != nil {
+		return 2
+	}
+
+	// Checked before any request is sent, so a typo cannot hide the result of e.g. a deletion
+	if c.output != OutputTable && c.output != OutputJson && c.output != OutputYaml {
+		fmt.Fprintf(stderr, "error: unknown output format %q, expected %s, %s or %s\n", c.output, OutputTable, OutputJson, OutputYaml)
+		return 2
+	}
+
+	args = flags.Args()
+	if len(args) == 0 || args[0] == "help" {
+		fmt.Fprint(stderr, usage)
+		if len(args) == 0 {
+			return 2
+		}
+		return 0
+	}
+
+	c.config, err = loadConfig(c.configPath)
+	if err == nil {
+		err = c.run(args)
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "error: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+func (c *command) run(args []string) error {
+	switch args[0] {
+	case "context":
+		return c.runContext(args[1:])
+	case "enforce":
+		return c.runEnforce(args[1:])
+	}
+
+	r, ok := resources[args[0]]
+	if !ok {
+		return fmt.Errorf("unknown command %q, run \"casdoorctl help\" for usage", args[0])
+	}
+	return c.runResource(args[0], r, args[1:])
+}
+
+func (c *command) getClient() (*casdoorsdk.Client, error) {
+	return c.config.getClient(c.contextName)
+}
+
+func (c *command) print(v interface{}, columns []string) error {
+	return printValue(c.stdout, c.output, v, columns)
+}
+
+// runEnforce runs "casdoorctl enforce", which prints whether the request is allowed.
+func (c *command) runEnforce(args []string) error {
+	flags := flag.NewFlagSet("enforce", flag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+	permissionId := flags.String("permission", "", "")
+	modelId := flags.String("model", "", "")
+	resourceId := flags.String("resource", "", "")
+	enforcerId := flags.String("enforcer", "", "")
+	owner := flags.String("owner", "", "")
+	err := flags.Parse(args)
+	if err != nil {
+		return err
+	}
+	if flags.NArg() == 0 {
+		return errors.New("enforce takes the request, e.g. \"alice data1 read\"")
+	}
+	if *permissionId == "" && *modelId == "" && *resourceId == "" && *enforcerId == "" && *owner == "" {
+		return errors.New("enforce needs one of --permission, --model, --resource, --enforcer or --owner")
+	}
+
+	client, err := c.getClient()
+	if err != nil {
+		return err
+	}
+
+	request := casdoorsdk.CasbinRequest{}
+	for _, arg := range flags.Args() {
+		request = append(request, arg)
+	}
+
+	allowed, err := client.Enforce(*permissionId, *modelId, *resourceId, *enforcerId, *owner, request)
+	if err != nil {
+		return err
+	}
+
+	result := map[string]interface{}{
+		"request": strings.Join(flags.Args(), ", "),
+		"allowed": allowed,
+	}
+	return c.print(result, []string{"request", "allowed"})
+}
+
+// runContext runs "casdoorctl context list|use|set|delete".
+func (c *command) runContext(args []string) error {
+	if len(args) == 0 {
+		return errors.New("missing verb, expected \"casdoorctl context list|use|set|delete\"")
+	}
+
+	verb := args[0]
+	if verb == "list" {
+		rows := []map[string]interface{}{}
+		for _, name := range c.config.getContextNames() {
+			context := c.config.Contexts[name]
+			current := ""
+			if name == c.config.CurrentContext {
+				current = "*"
+			}
+			rows = append(rows, map[string]interface{}{
+				"current":      current,
+				"name":         name,
+				"endpoint":     context.Endpoint,
+				"organization": context.OrganizationName,
+				"application":  context.ApplicationName,
+			})
+		}
+		return c.print(rows, []string{"current", "name", "endpoint", "organization", "application"})
+	}
+
+	if len(args) < 2 {
+		return fmt.Errorf("context %s takes the name of the context", verb)
+	}
+	name := args[1]
+
+	switch verb {
+	case "use":
+		if _, ok := c.config.Contexts[name]; !ok {
+			return fmt.Errorf("context %q not found", name)
+		}
+		c.config.CurrentContext = name
+	case "delete":
+		if _, ok := c.config.Contexts[name]; !ok {
+			return fmt.Errorf("context %q not found", name)
+		}
+		delete(c.config.Contexts, name)
+		if c.config.CurrentContext == name {
+			c.config.CurrentContext = ""
+		}
+	case "set":
+		err := c.setContext(name, args[2:])
+		if err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unknown verb %q, expected list, use, set or delete", verb)
+	}
+
+	return saveConfig(c.configPath, c.config)
+}
+
+// setContext creates the named context or updates the fields given as flags, and selects it if no context is selected.
+func (c *command) setContext(name string, args []string) error {
+	context, ok := c.config.Contexts[name]
+	if !ok {
+		context = &Context{}
+		c.config.Contexts[name] = context
+	}
+
+	flags := flag.NewFlagSet("context set", flag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+	flags.StringVar(&context.Endpoint, "endpoint", context.Endpoint, "")
+	flags.StringVar(&context.ClientId, "client-id", context.ClientId, "")
+	flags.StringVar(&context.ClientSecret, "client-secret", context.ClientSecret, "")
+	flags.StringVar(&context.OrganizationName, "organization", context.OrganizationName, "")
+	flags.StringVar(&context.ApplicationName, "application", context.ApplicationName, "")
+	certificateFile := flags.String("certificate-file", "", "")
+	err := flags.Parse(args)
+	if err != nil {
+		return err
+	}
+	if flags.NArg() != 0 {
+		return fmt.Errorf("unexpected arguments: %s", strings.Join(flags.Args(), " "))
+	}
+
+	if *certificateFile != "" {
+		certificate, err := os.ReadFile(*certificateFile)
+		if err != nil {
+			return err
+		}
+		context.Certificate = string(certificate)
+	}
+	context.Endpoint = strings.TrimRight(context.Endpoint, "/")
+
+	if c.config.CurrentContext == "" {
+		c.config.CurrentContext = name
+	}
+	return nil
+}
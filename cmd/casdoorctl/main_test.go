@@ -0,0 +1,124 @@
+// Copyright 2025 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func runTest(t *testing.T, args ...string) string {
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	code := run(args, stdout, stderr)
+	if code != 0 {
+		t.Fatalf("casdoorctl %s exited with %d: %s", strings.Join(args, " "), code, stderr.String())
+	}
+	return stdout.String()
+}
+
+func TestCasdoorctl(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/get-users":
+			w.Write([]byte(`{"status":"ok","msg":"","data":[{"owner":"built-in","name":"alice","displayName":"Alice","isAdmin":true},{"owner":"built-in","name":"bob","displayName":"Bob"}]}`))
+		case "/api/get-role":
+			if r.URL.Query().Get("id") != "built-in/admin" {
+				w.Write([]byte(`{"status":"ok","msg":"","data":null}`))
+				return
+			}
+			w.Write([]byte(`{"status":"ok","msg":"","data":{"owner":"built-in","name":"admin","users":["built-in/alice"],"roles":[],"isEnabled":true}}`))
+		case "/api/enforce":
+			w.Write([]byte(`{"status":"ok","msg":"","data":[true]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	config := filepath.Join(t.TempDir(), "config.json")
+	runTest(t, "--config", config, "context", "set", "test", "--endpoint", server.URL+"/", "--organization", "built-in", "--application", "app-built-in")
+
+	output := runTest(t, "--config", config, "context", "list")
+	if !strings.Contains(output, "*         test   "+server.URL+"   built-in") {
+		t.Fatalf("Unexpected contexts:\n%s", output)
+	}
+
+	output = runTest(t, "--config", config, "users", "list")
+	expected := "OWNER      NAME    DISPLAY NAME   EMAIL   PHONE   TYPE   IS ADMIN\n" +
+		"built-in   alice   Alice                                 true\n" +
+		"built-in   bob     Bob                                   false\n"
+	if output != expected {
+		t.Fatalf("Unexpected table:\n%s", output)
+	}
+
+	output = runTest(t, "--config", config, "-o", "yaml", "roles", "get", "admin")
+	if !strings.Contains(output, "isEnabled: true\n") || !strings.Contains(output, "roles: []\n") || !strings.Contains(output, "users:\n  - built-in/alice\n") {
+		t.Fatalf("Unexpected yaml:\n%s", output)
+	}
+
+	code := run([]string{"--config", config, "roles", "get", "missing"}, &bytes.Buffer{}, &bytes.Buffer{})
+	if code != 1 {
+		t.Fatalf("Expected a missing role to fail, got exit code %d", code)
+	}
+
+	// An invalid output format fails before the request is sent
+	requests := 0
+	server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	})
+	code = run([]string{"--config", config, "-o", "xml", "users", "delete", "alice"}, &bytes.Buffer{}, &bytes.Buffer{})
+	if code != 2 || requests != 0 {
+		t.Fatalf("Expected an invalid output format to fail without requests, got exit code %d and %d requests", code, requests)
+	}
+	server.Config.Handler = handler
+
+	output = runTest(t, "--config", config, "-o", "json", "enforce", "--permission", "built-in/permission-built-in", "alice", "data1", "read")
+	if !strings.Contains(output, `"allowed": true`) {
+		t.Fatalf("Unexpected enforce result:\n%s", output)
+	}
+}
+
+func TestWriteYaml(t *testing.T) {
+	value := map[string]interface{}{
+		"name":    "alice",
+		"empty":   "",
+		"number":  "123",
+		"url":     "https://example.com",
+		"nested":  map[string]interface{}{"key": true},
+		"objects": []interface{}{map[string]interface{}{"a": "1x", "b": nil}},
+	}
+
+	buf := &bytes.Buffer{}
+	writeYaml(buf, value, 0)
+	expected := `empty: ""
+name: alice
+nested:
+  key: true
+number: "123"
+objects:
+  - a: 1x
+    b: null
+url: "https://example.com"
+`
+	if buf.String() != expected {
+		t.Fatalf("Unexpected yaml:\n%s", buf.String())
+	}
+}
@@ -0,0 +1,229 @@
+// Copyright 2025 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"unicode"
+)
+
+// Output formats, selected with --output.
+const (
+	OutputJson  = "json"
+	OutputYaml  = "yaml"
+	OutputTable = "table"
+)
+
+// printValue writes v in the given format. Tables show the given columns, named by their JSON field names,
+// and have one row per element if v is a list.
+func printValue(w io.Writer, format string, v interface{}, columns []string) error {
+	switch format {
+	case OutputJson:
+		data, err := json.MarshalIndent(v, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(w, string(data))
+		return err
+	case OutputYaml:
+		value, err := toGeneric(v)
+		if err != nil {
+			return err
+		}
+		buf := &bytes.Buffer{}
+		writeYaml(buf, value, 0)
+		_, err = w.Write(buf.Bytes())
+		return err
+	case OutputTable:
+		value, err := toGeneric(v)
+		if err != nil {
+			return err
+		}
+		return writeTable(w, value, columns)
+	default:
+		return fmt.Errorf("unknown output format %q, expected %s, %s or %s", format, OutputJson, OutputYaml, OutputTable)
+	}
+}
+
+// toGeneric converts v to the maps, slices and scalars of its JSON representation.
+func toGeneric(v interface{}) (interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var value interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	err = decoder.Decode(&value)
+	return value, err
+}
+
+// writeYaml writes a generic value as a YAML block, with map keys in alphabetical order.
+func writeYaml(buf *bytes.Buffer, v interface{}, indent int) {
+	prefix := strings.Repeat(" ", indent)
+
+	switch value := v.(type) {
+	case map[string]interface{}:
+		if len(value) == 0 {
+			buf.WriteString(prefix + "{}\n")
+			return
+		}
+
+		keys := make([]string, 0, len(value))
+		for key := range value {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			buf.WriteString(prefix + formatYamlScalar(key) + ":")
+			if isYamlBlock(value[key]) {
+				buf.WriteString("\n")
+				writeYaml(buf, value[key], indent+2)
+			} else {
+				buf.WriteString(" " + formatYamlValue(value[key]) + "\n")
+			}
+		}
+	case []interface{}:
+		if len(value) == 0 {
+			buf.WriteString(prefix + "[]\n")
+			return
+		}
+
+		for _, item := range value {
+			if !isYamlBlock(item) {
+				buf.WriteString(prefix + "- " + formatYamlValue(item) + "\n")
+				continue
+			}
+
+			// The item is written indented, and its first line's indentation replaced by the dash
+			itemBuf := &bytes.Buffer{}
+			writeYaml(itemBuf, item, indent+2)
+			buf.WriteString(prefix + "- ")
+			buf.Write(itemBuf.Bytes()[indent+2:])
+		}
+	default:
+		buf.WriteString(prefix + formatYamlValue(v) + "\n")
+	}
+}
+
+// isYamlBlock returns true if v is written on its own lines, i.e. it is a non-empty map or list.
+func isYamlBlock(v interface{}) bool {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		return len(value) != 0
+	case []interface{}:
+		return len(value) != 0
+	}
+	return false
+}
+
+func formatYamlValue(v interface{}) string {
+	switch value := v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return strconv.FormatBool(value)
+	case json.Number:
+		return value.String()
+	case string:
+		return formatYamlScalar(value)
+	case map[string]interface{}:
+		return "{}"
+	case []interface{}:
+		return "[]"
+	}
+	return formatYamlScalar(fmt.Sprint(v))
+}
+
+// formatYamlScalar returns s as a plain YAML scalar, or double-quoted if it would otherwise be read as another type or be invalid.
+func formatYamlScalar(s string) string {
+	if s == "" || s != strings.TrimSpace(s) || strings.ContainsAny(s, ":#\"\n\t") || strings.ContainsRune("-?[]{},&*!|>'%@`", rune(s[0])) {
+		return strconv.Quote(s)
+	}
+
+	switch strings.ToLower(s) {
+	case "null", "~", "true", "false", "yes", "no", "on", "off":
+		return strconv.Quote(s)
+	}
+	if _, err := strconv.ParseFloat(s, 64); err == nil {
+		return strconv.Quote(s)
+	}
+	return s
+}
+
+// writeTable writes a list of objects, or a single object, as a table with the given columns.
+func writeTable(w io.Writer, v interface{}, columns []string) error {
+	rows, ok := v.([]interface{})
+	if !ok {
+		rows = []interface{}{v}
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+
+	headers := []string{}
+	for _, column := range columns {
+		headers = append(headers, getColumnHeader(column))
+	}
+	fmt.Fprintln(tw, strings.Join(headers, "\t"))
+
+	for _, row := range rows {
+		object, _ := row.(map[string]interface{})
+		cells := []string{}
+		for _, column := range columns {
+			cells = append(cells, formatCell(object[column]))
+		}
+		fmt.Fprintln(tw, strings.Join(cells, "\t"))
+	}
+
+	return tw.Flush()
+}
+
+// getColumnHeader turns a JSON field name like "displayName" into a header like "DISPLAY NAME".
+func getColumnHeader(column string) string {
+	header := strings.Builder{}
+	for i, r := range column {
+		if i > 0 && unicode.IsUpper(r) {
+			header.WriteRune(' ')
+		}
+		header.WriteRune(unicode.ToUpper(r))
+	}
+	return header.String()
+}
+
+func formatCell(v interface{}) string {
+	switch value := v.(type) {
+	case nil:
+		return ""
+	case []interface{}:
+		items := []string{}
+		for _, item := range value {
+			items = append(items, formatCell(item))
+		}
+		return strings.Join(items, ",")
+	case map[string]interface{}:
+		data, _ := json.Marshal(value)
+		return string(data)
+	}
+	return fmt.Sprint(v)
+}
@@ -0,0 +1,187 @@
+// Copyright 2025 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/casdoor/casdoor-go-sdk/casdoorsdk"
+)
+
+// resource describes how to list, get and delete one kind of Casdoor object.
+// get returns nil without an error if the object does not exist.
+type resource struct {
+	columns []string
+	list    func(client *casdoorsdk.Client) (interface{}, error)
+	get     func(client *casdoorsdk.Client, name string) (interface{}, error)
+	delete  func(client *casdoorsdk.Client, name string) (bool, error)
+}
+
+var resources = map[string]*resource{
+	"users": {
+		columns: []string{"owner", "name", "displayName", "email", "phone", "type", "isAdmin"},
+		list: func(client *casdoorsdk.Client) (interface{}, error) {
+			return client.GetUsers()
+		},
+		get: func(client *casdoorsdk.Client, name string) (interface{}, error) {
+			user, err := client.GetUser(name)
+			if err != nil || user == nil {
+				return nil, err
+			}
+			return user, nil
+		},
+		delete: func(client *casdoorsdk.Client, name string) (bool, error) {
+			user, err := client.GetUser(name)
+			if err != nil || user == nil {
+				return false, err
+			}
+			return client.DeleteUser(user)
+		},
+	},
+	"roles": {
+		columns: []string{"owner", "name", "displayName", "users", "roles", "isEnabled"},
+		list: func(client *casdoorsdk.Client) (interface{}, error) {
+			return client.GetRoles()
+		},
+		get: func(client *casdoorsdk.Client, name string) (interface{}, error) {
+			role, err := client.GetRole(name)
+			if err != nil || role == nil {
+				return nil, err
+			}
+			return role, nil
+		},
+		delete: func(client *casdoorsdk.Client, name string) (bool, error) {
+			role, err := client.GetRole(name)
+			if err != nil || role == nil {
+				return false, err
+			}
+			return client.DeleteRole(role)
+		},
+	},
+	"permissions": {
+		columns: []string{"owner", "name", "model", "resources", "actions", "effect", "isEnabled"},
+		list: func(client *casdoorsdk.Client) (interface{}, error) {
+			return client.GetPermissions()
+		},
+		get: func(client *casdoorsdk.Client, name string) (interface{}, error) {
+			permission, err := client.GetPermission(name)
+			if err != nil || permission == nil {
+				return nil, err
+			}
+			return permission, nil
+		},
+		delete: func(client *casdoorsdk.Client, name string) (bool, error) {
+			permission, err := client.GetPermission(name)
+			if err != nil || permission == nil {
+				return false, err
+			}
+			return client.DeletePermission(permission)
+		},
+	},
+	"applications": {
+		columns: []string{"owner", "name", "displayName", "organization", "clientId"},
+		list: func(client *casdoorsdk.Client) (interface{}, error) {
+			return client.GetApplications()
+		},
+		get: func(client *casdoorsdk.Client, name string) (interface{}, error) {
+			application, err := client.GetApplication(name)
+			if err != nil || application == nil {
+				return nil, err
+			}
+			return application, nil
+		},
+		delete: func(client *casdoorsdk.Client, name string) (bool, error) {
+			application, err := client.GetApplication(name)
+			if err != nil || application == nil {
+				return false, err
+			}
+			return client.DeleteApplication(application)
+		},
+	},
+	"tokens": {
+		columns: []string{"owner", "name", "application", "user", "scope", "expiresIn", "createdTime"},
+		list: func(client *casdoorsdk.Client) (interface{}, error) {
+			return client.GetTokens()
+		},
+		get: func(client *casdoorsdk.Client, name string) (interface{}, error) {
+			token, err := client.GetToken(name)
+			if err != nil || token == nil {
+				return nil, err
+			}
+			return token, nil
+		},
+		delete: func(client *casdoorsdk.Client, name string) (bool, error) {
+			token, err := client.GetToken(name)
+			if err != nil || token == nil {
+				return false, err
+			}
+			return client.DeleteToken(token)
+		},
+	},
+}
+
+// runResource runs "casdoorctl <resource> list|get|delete [name]".
+func (c *command) runResource(kind string, r *resource, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("missing verb, expected \"casdoorctl %s list|get|delete\"", kind)
+	}
+
+	client, err := c.getClient()
+	if err != nil {
+		return err
+	}
+
+	verb := args[0]
+	if verb == "list" {
+		if len(args) != 1 {
+			return errors.New("list takes no arguments")
+		}
+		objects, err := r.list(client)
+		if err != nil {
+			return err
+		}
+		return c.print(objects, r.columns)
+	}
+
+	if verb != "get" && verb != "delete" {
+		return fmt.Errorf("unknown verb %q, expected list, get or delete", verb)
+	}
+	if len(args) != 2 {
+		return fmt.Errorf("%s takes the name of the object", verb)
+	}
+	name := args[1]
+
+	if verb == "get" {
+		object, err := r.get(client, name)
+		if err != nil {
+			return err
+		}
+		if object == nil {
+			return fmt.Errorf("%s %q not found", kind, name)
+		}
+		return c.print(object, r.columns)
+	}
+
+	affected, err := r.delete(client, name)
+	if err != nil {
+		return err
+	}
+	if !affected {
+		return fmt.Errorf("%s %q not found", kind, name)
+	}
+	_, err = fmt.Fprintf(c.stdout, "%s %q deleted\n", kind, name)
+	return err
+}
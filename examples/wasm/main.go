@@ -0,0 +1,43 @@
+// Copyright 2025 The Casdoor Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command wasm validates a Casdoor token offline, as an edge worker would. TinyGo selects the SDK's
+// build without net/http, mime/multipart and golang.org/x/oauth2 through its tinygo build tag:
+//
+//	tinygo build -o token.wasm -target=wasip1 ./examples/wasm
+//	CASDOOR_CERTIFICATE="$(cat cert.pem)" wasmtime --env CASDOOR_CERTIFICATE token.wasm <token>
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/casdoor/casdoor-go-sdk/casdoorsdk"
+)
+
+func main() {
+	if len(os.Args) != 2 {
+		fmt.Fprintln(os.Stderr, "usage: token.wasm <token>")
+		os.Exit(2)
+	}
+
+	client := casdoorsdk.NewClient("", "", "", os.Getenv("CASDOOR_CERTIFICATE"), "", "")
+	claims, err := client.ParseJwtToken(os.Args[1])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "invalid token: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("%s/%s\n", claims.Owner, claims.Name)
+}